@@ -28,18 +28,45 @@ var checkCommand = &cobra.Command{
 	Use:   "check [FILE...]",
 	Short: "Check Mathlingua files for errors",
 	Long: "Checks the specified Mathlingua (.math) files for errors, defaulting to all Mathlingua " +
-		"files in the 'content' directory and all sub-directories if none are explicitly provided.",
+		"files in the 'content' directory and all sub-directories if none are explicitly provided.\n\n" +
+		"Exits with 0 if the check passes, 1 if errors are found, 2 if more warnings than " +
+		"--max-warnings are found, and 3 if the check could not be completed.",
 	Args: cobra.MinimumNArgs(0),
 	Run: func(cmd *cobra.Command, args []string) {
 		debug, _ := rootCmd.PersistentFlags().GetBool("debug")
 		json, _ := cmd.Flags().GetBool("json")
+		baseline, _ := cmd.Flags().GetString("baseline")
+		writeBaseline, _ := cmd.Flags().GetString("write-baseline")
+		maxWarnings, _ := cmd.Flags().GetInt("max-warnings")
+		summaryJson, _ := cmd.Flags().GetString("summary-json")
+		archive, _ := cmd.Flags().GetString("archive")
 
 		logger := logger.NewLogger(os.Stdout)
-		mlg.NewMlg(logger).Check(args, json, debug)
+		exitCode := mlg.NewMlg(logger).Check(args, mlg.CheckOptions{
+			ShowJson:          json,
+			Debug:             debug,
+			BaselinePath:      baseline,
+			WriteBaselinePath: writeBaseline,
+			MaxWarnings:       maxWarnings,
+			SummaryJsonPath:   summaryJson,
+			ArchivePath:       archive,
+		})
+		os.Exit(exitCode)
 	},
 }
 
 func init() {
 	checkCommand.Flags().BoolP("json", "j", false, "Output diagnostics in JSON format")
+	checkCommand.Flags().String("baseline", "",
+		"Only report diagnostics that are not recorded in the given baseline file")
+	checkCommand.Flags().String("write-baseline", "",
+		"Record all current diagnostics in the given baseline file")
+	checkCommand.Flags().Int("max-warnings", -1,
+		"Fail if more than the given number of warnings are found (-1 for no limit)")
+	checkCommand.Flags().String("summary-json", "",
+		"Write a JSON summary of the diagnostics found to the given file")
+	checkCommand.Flags().String("archive", "",
+		"Check the collection in the given zip archive, where any FILE arguments are paths "+
+			"within the archive, instead of the files on disk")
 	rootCmd.AddCommand(checkCommand)
 }
@@ -20,9 +20,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"mathlingua/internal/ast"
+	"mathlingua/internal/backend"
 	"mathlingua/internal/logger"
 	"mathlingua/internal/mlg"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -32,11 +34,21 @@ var completionsCommand = &cobra.Command{
 	Use:    "completions",
 	Hidden: true,
 	Run: func(cmd *cobra.Command, args []string) {
-		printCompletions()
+		path, _ := cmd.Flags().GetString("path")
+		if path == "" {
+			printCompletions()
+			return
+		}
+		row, _ := cmd.Flags().GetInt("row")
+		col, _ := cmd.Flags().GetInt("col")
+		printCompletionsAt(path, row, col)
 	},
 }
 
 func init() {
+	completionsCommand.Flags().String("path", "", "The file to complete in")
+	completionsCommand.Flags().Int("row", 1, "The (one based) row of the cursor")
+	completionsCommand.Flags().Int("col", 1, "The (one based) column of the cursor")
 	rootCmd.AddCommand(completionsCommand)
 }
 
@@ -62,6 +74,30 @@ func printCompletions() {
 	}
 }
 
+type completionsAtResult struct {
+	Completions []backend.Completion
+}
+
+func printCompletionsAt(path string, row int, col int) {
+	logger := logger.NewLogger(os.Stdout)
+	completions := mlg.NewMlg(logger).GetCompletions(
+		ast.ToPath(filepath.ToSlash(filepath.Clean(path))),
+		ast.Position{
+			Row:    row - 1,
+			Column: col - 1,
+		})
+
+	result := completionsAtResult{
+		Completions: completions,
+	}
+
+	if data, err := json.MarshalIndent(result, "", "  "); err != nil {
+		fmt.Println("{\"Completions\": []}")
+	} else {
+		fmt.Println(string(data))
+	}
+}
+
 var FIXED_COMPLETIONS = getFixedCompletions()
 
 func getFixedCompletions() []string {
@@ -134,6 +170,8 @@ func getFixedCompletions() []string {
 		join(ast.MonthSections),
 		join(ast.YearSections),
 		join(ast.DescriptionSections),
+		join(ast.TopicSections),
+		join(ast.NoteSections),
 		join(ast.ProofByBecauseThenSections),
 		join(ast.ProofBecauseThenSections),
 		join(ast.ProofStepwiseSections),
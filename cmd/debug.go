@@ -318,7 +318,7 @@ func parseForStructural(text string) (string, string, *frontend.DiagnosticTracke
 	lexer3 := phase3.NewLexer(lexer2, "", tracker)
 
 	root := phase4.Parse(lexer3, "", tracker)
-	doc, ok := phase5.Parse(root, "", tracker, mlglib.NewKeyGenerator())
+	doc, ok := phase5.Parse(root, "", tracker, mlglib.NewKeyGenerator(), nil, nil)
 
 	backend.CheckRequirements(ast.ToPath("/"), &doc, tracker)
 
@@ -355,7 +355,7 @@ func parse(text string) (ast.Document, frontend.IDiagnosticTracker) {
 func parseForFormulation(text string) (string, string, *frontend.DiagnosticTracker) {
 	tracker := frontend.NewDiagnosticTracker()
 	node, ok := formulation.ParseExpression(
-		"", text, ast.Position{}, tracker, mlglib.NewKeyGenerator())
+		"", text, ast.Position{}, tracker, mlglib.NewKeyGenerator(), nil, nil)
 	backend.CheckRequirements(ast.ToPath("/"), node, tracker)
 	astText := ""
 	if ok {
@@ -382,7 +382,8 @@ func parse(text string) (ast.MlgNodeKind, frontend.IDiagnosticTracker) {
 
 func parseForForm(text string) (string, string, *frontend.DiagnosticTracker) {
 	tracker := frontend.NewDiagnosticTracker()
-	node, ok := formulation.ParseForm("", text, ast.Position{}, tracker, mlglib.NewKeyGenerator())
+	node, ok := formulation.ParseForm(
+		"", text, ast.Position{}, tracker, mlglib.NewKeyGenerator(), nil, nil)
 	backend.CheckRequirements(ast.ToPath("/"), node, tracker)
 	astText := ""
 	if ok {
@@ -410,7 +411,7 @@ func parse(text string) (ast.MlgNodeKind, frontend.IDiagnosticTracker) {
 func parseForSignature(text string) (string, string, *frontend.DiagnosticTracker) {
 	tracker := frontend.NewDiagnosticTracker()
 	node, ok := formulation.ParseSignature(
-		"", text, ast.Position{}, tracker, mlglib.NewKeyGenerator())
+		"", text, ast.Position{}, tracker, mlglib.NewKeyGenerator(), nil)
 	backend.CheckRequirements(ast.ToPath("/"), &node, tracker)
 	astText := ""
 	if ok {
@@ -423,7 +424,8 @@ func parseForSignature(text string) (string, string, *frontend.DiagnosticTracker
 
 func parseForId(text string) (string, string, *frontend.DiagnosticTracker) {
 	tracker := frontend.NewDiagnosticTracker()
-	node, ok := formulation.ParseId("", text, ast.Position{}, tracker, mlglib.NewKeyGenerator())
+	node, ok := formulation.ParseId(
+		"", text, ast.Position{}, tracker, mlglib.NewKeyGenerator(), nil, nil)
 	backend.CheckRequirements(ast.ToPath("/"), node, tracker)
 	astText := ""
 	if ok {
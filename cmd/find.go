@@ -0,0 +1,45 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"mathlingua/internal/logger"
+	"mathlingua/internal/mlg"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var findCommand = &cobra.Command{
+	Use:   "find QUERY",
+	Short: "Find formulations matching a pattern",
+	Long: "Finds the formulations in the then: and means: sections of the entries in the " +
+		"current directory that match the given query.  A name followed by ? in the query " +
+		"matches any expression, and everything else must match exactly.  For example, " +
+		"'x? + y? = y? + x?' finds statements of commutativity.",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		json, _ := cmd.Flags().GetBool("json")
+		logger := logger.NewLogger(os.Stdout)
+		os.Exit(mlg.NewMlg(logger).Find(args[0], json))
+	},
+}
+
+func init() {
+	findCommand.Flags().BoolP("json", "j", false, "Output the matches in JSON format")
+	rootCmd.AddCommand(findCommand)
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"mathlingua/internal/logger"
+	"mathlingua/internal/mlg"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var fixCommand = &cobra.Command{
+	Use:   "fix [FILE...]",
+	Short: "Apply fixes for common problems in Mathlingua files",
+	Long: "Applies the fixes for common problems, such as a missing Id: section or sections " +
+		"in the wrong order, to the specified Mathlingua (.math) files in place, defaulting to " +
+		"all Mathlingua files in the current directory and all sub-directories if none are " +
+		"explicitly provided.",
+	Args: cobra.MinimumNArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		unsafe, _ := cmd.Flags().GetBool("unsafe")
+		logger := logger.NewLogger(os.Stdout)
+		os.Exit(mlg.NewMlg(logger).Fix(args, unsafe))
+	},
+}
+
+func init() {
+	fixCommand.Flags().Bool("unsafe", false,
+		"Also apply fixes that remove content or guess at what was meant, such as replacing an "+
+			"unrecognized signature that is not a simple typo or adding a placeholder "+
+			"Documented:written: section")
+	rootCmd.AddCommand(fixCommand)
+}
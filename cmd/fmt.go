@@ -0,0 +1,62 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"mathlingua/internal/backend"
+	"mathlingua/internal/logger"
+	"mathlingua/internal/mlg"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var fmtCommand = &cobra.Command{
+	Use:   "fmt [FILE...]",
+	Short: "Format Mathlingua files",
+	Long: "Formats the specified Mathlingua (.math) files in place, defaulting to all Mathlingua " +
+		"files in the current directory and all sub-directories if none are explicitly provided.",
+	Args: cobra.MinimumNArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		ascii, _ := cmd.Flags().GetBool("ascii")
+		unicode, _ := cmd.Flags().GetBool("unicode")
+		reorderSections, _ := cmd.Flags().GetBool("reorder-sections")
+
+		options := backend.FormatOptions{
+			ReorderSections: reorderSections,
+		}
+		if ascii {
+			options.Symbols = backend.AsciiSymbols
+		} else if unicode {
+			options.Symbols = backend.UnicodeSymbols
+		}
+
+		logger := logger.NewLogger(os.Stdout)
+		os.Exit(mlg.NewMlg(logger).Fmt(args, options))
+	},
+}
+
+func init() {
+	fmtCommand.Flags().Bool("ascii", false,
+		"Write Unicode symbols in formulations in their ASCII or command forms (for example ≤ as <=)")
+	fmtCommand.Flags().Bool("unicode", false,
+		"Write the ASCII forms of symbols in formulations as Unicode symbols (for example <= as ≤)")
+	fmtCommand.Flags().Bool("reorder-sections", false,
+		"Move the sections of each group to the order the group expects")
+	fmtCommand.MarkFlagsMutuallyExclusive("ascii", "unicode")
+	rootCmd.AddCommand(fmtCommand)
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"mathlingua/internal/logger"
+	"mathlingua/internal/mlg"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var hierarchyCommand = &cobra.Command{
+	Use:   "hierarchy SIGNATURE",
+	Short: "Show the hierarchy of a Describes: entry",
+	Long: "Shows the entries that the Describes: entry with the given signature, such as " +
+		"\\ring, extends or satisfies as a tree, followed by the entries that extend or " +
+		"satisfy it and the entries whose specifies: section uses it.",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		json, _ := cmd.Flags().GetBool("json")
+		logger := logger.NewLogger(os.Stdout)
+		os.Exit(mlg.NewMlg(logger).Hierarchy(args[0], json))
+	},
+}
+
+func init() {
+	hierarchyCommand.Flags().BoolP("json", "j", false, "Output the hierarchy in JSON format")
+	rootCmd.AddCommand(hierarchyCommand)
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"mathlingua/internal/logger"
+	"mathlingua/internal/mlg"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var unfoldCommand = &cobra.Command{
+	Use:   "unfold ID_OR_SIGNATURE",
+	Short: "Show an entry with the definitions it uses unfolded",
+	Long: "Prints the entry with the given id or signature, such as \\even, where each " +
+		"statement 'x is \\c' is replaced with the means: clauses of the Defines: entry, " +
+		"or the satisfies: clauses of the Describes: entry, for \\c.",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		depth, _ := cmd.Flags().GetInt("depth")
+		logger := logger.NewLogger(os.Stdout)
+		os.Exit(mlg.NewMlg(logger).Unfold(args[0], depth))
+	},
+}
+
+func init() {
+	unfoldCommand.Flags().Int("depth", -1,
+		"The number of levels of definitions to unfold (-1 for no limit)")
+	rootCmd.AddCommand(unfoldCommand)
+}
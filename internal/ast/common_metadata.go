@@ -70,6 +70,8 @@ func (n *PersonGroup) GetCommonMetaData() *CommonMetaData        { return &n.Com
 func (n *NameGroup) GetCommonMetaData() *CommonMetaData          { return &n.CommonMetaData }
 func (n *BiographyGroup) GetCommonMetaData() *CommonMetaData     { return &n.CommonMetaData }
 func (n *ResourceGroup) GetCommonMetaData() *CommonMetaData      { return &n.CommonMetaData }
+func (n *NoteGroup) GetCommonMetaData() *CommonMetaData          { return &n.CommonMetaData }
+func (n *TopicGroup) GetCommonMetaData() *CommonMetaData         { return &n.CommonMetaData }
 func (n *TitleGroup) GetCommonMetaData() *CommonMetaData         { return &n.CommonMetaData }
 func (n *AuthorGroup) GetCommonMetaData() *CommonMetaData        { return &n.CommonMetaData }
 func (n *OffsetGroup) GetCommonMetaData() *CommonMetaData        { return &n.CommonMetaData }
@@ -88,6 +90,7 @@ func (n *DescriptionGroup) GetCommonMetaData() *CommonMetaData   { return &n.Com
 func (n *Document) GetCommonMetaData() *CommonMetaData           { return &n.CommonMetaData }
 func (n *TextBlockItem) GetCommonMetaData() *CommonMetaData      { return &n.CommonMetaData }
 func (n *CapturesGroup) GetCommonMetaData() *CommonMetaData      { return &n.CommonMetaData }
+func (n *ErrorGroup) GetCommonMetaData() *CommonMetaData         { return &n.CommonMetaData }
 
 func (n *NameForm) GetCommonMetaData() *CommonMetaData                 { return &n.CommonMetaData }
 func (n *SymbolForm) GetCommonMetaData() *CommonMetaData               { return &n.CommonMetaData }
@@ -238,6 +238,12 @@ func (n *SymbolWrittenGroup) ForEach(fn func(subNode MlgNodeKind)) {
 	if n.Written != nil {
 		forEachTextItem(n.Written.Written, fn)
 	}
+	if n.Precedence != nil {
+		fn(&n.Precedence.Precedence)
+	}
+	if n.Associativity != nil {
+		fn(&n.Associativity.Associativity)
+	}
 }
 
 func (n *ComparisonGroup) ForEach(fn func(subNode MlgNodeKind)) {
@@ -342,6 +348,9 @@ func (n *DescribesGroup) ForEach(fn func(subNode MlgNodeKind)) {
 	if n.Aliases != nil {
 		forEachAlias(n.Aliases.Aliases, fn)
 	}
+	if n.Viewable != nil {
+		fn(&n.Viewable.Viewable)
+	}
 	if n.MetaId != nil {
 		fn(&n.MetaId.Id)
 	}
@@ -386,6 +395,9 @@ func (n *DefinesGroup) ForEach(fn func(subNode MlgNodeKind)) {
 	if n.Aliases != nil {
 		forEachAlias(n.Aliases.Aliases, fn)
 	}
+	if n.Viewable != nil {
+		fn(&n.Viewable.Viewable)
+	}
 	if n.MetaId != nil {
 		fn(&n.MetaId.Id)
 	}
@@ -403,6 +415,9 @@ func (n *CapturesGroup) ForEach(fn func(subNode MlgNodeKind)) {
 	if n.References != nil {
 		forEachTextItem(n.References.References, fn)
 	}
+	if n.Viewable != nil {
+		fn(&n.Viewable.Viewable)
+	}
 	if n.MetaId != nil {
 		fn(&n.MetaId.Id)
 	}
@@ -437,6 +452,9 @@ func (n *StatesGroup) ForEach(fn func(subNode MlgNodeKind)) {
 	if n.Aliases != nil {
 		forEachAlias(n.Aliases.Aliases, fn)
 	}
+	if n.Viewable != nil {
+		fn(&n.Viewable.Viewable)
+	}
 	if n.MetaId != nil {
 		fn(&n.MetaId.Id)
 	}
@@ -475,6 +493,9 @@ func (n *AxiomGroup) ForEach(fn func(subNode MlgNodeKind)) {
 	if n.Aliases != nil {
 		forEachAlias(n.Aliases.Aliases, fn)
 	}
+	if n.Viewable != nil {
+		fn(&n.Viewable.Viewable)
+	}
 	if n.MetaId != nil {
 		fn(&n.MetaId.Id)
 	}
@@ -513,6 +534,9 @@ func (n *ConjectureGroup) ForEach(fn func(subNode MlgNodeKind)) {
 	if n.Aliases != nil {
 		forEachAlias(n.Aliases.Aliases, fn)
 	}
+	if n.Viewable != nil {
+		fn(&n.Viewable.Viewable)
+	}
 	if n.MetaId != nil {
 		fn(&n.MetaId.Id)
 	}
@@ -554,6 +578,9 @@ func (n *TheoremGroup) ForEach(fn func(subNode MlgNodeKind)) {
 	if n.Aliases != nil {
 		forEachAlias(n.Aliases.Aliases, fn)
 	}
+	if n.Viewable != nil {
+		fn(&n.Viewable.Viewable)
+	}
 	if n.MetaId != nil {
 		fn(&n.MetaId.Id)
 	}
@@ -596,6 +623,9 @@ func (n *CorollaryGroup) ForEach(fn func(subNode MlgNodeKind)) {
 	if n.Aliases != nil {
 		forEachAlias(n.Aliases.Aliases, fn)
 	}
+	if n.Viewable != nil {
+		fn(&n.Viewable.Viewable)
+	}
 	if n.MetaId != nil {
 		fn(&n.MetaId.Id)
 	}
@@ -638,6 +668,9 @@ func (n *LemmaGroup) ForEach(fn func(subNode MlgNodeKind)) {
 	if n.Aliases != nil {
 		forEachAlias(n.Aliases.Aliases, fn)
 	}
+	if n.Viewable != nil {
+		fn(&n.Viewable.Viewable)
+	}
 	if n.MetaId != nil {
 		fn(&n.MetaId.Id)
 	}
@@ -685,6 +718,37 @@ func (n *ResourceGroup) ForEach(fn func(subNode MlgNodeKind)) {
 	forEach(n.Resource.Items, fn)
 }
 
+func (n *TopicGroup) ForEach(fn func(subNode MlgNodeKind)) {
+	fn(&n.Topic.Name)
+	fn(&n.Content.Content)
+	forEachFormulation(n.Members.Members, fn)
+	if n.Documented != nil {
+		forEach(n.Documented.Documented, fn)
+	}
+	if n.References != nil {
+		forEachTextItem(n.References.References, fn)
+	}
+	if n.Viewable != nil {
+		fn(&n.Viewable.Viewable)
+	}
+	if n.MetaId != nil {
+		fn(&n.MetaId.Id)
+	}
+}
+
+func (n *NoteGroup) ForEach(fn func(subNode MlgNodeKind)) {
+	fn(&n.Note.Note)
+	if n.On != nil {
+		fn(&n.On.On)
+	}
+	if n.Viewable != nil {
+		fn(&n.Viewable.Viewable)
+	}
+	if n.MetaId != nil {
+		fn(&n.MetaId.Id)
+	}
+}
+
 func (n *TitleGroup) ForEach(fn func(subNode MlgNodeKind)) {
 	fn(&n.Title.Title)
 }
@@ -755,6 +819,10 @@ func (n *TextBlockItem) ForEach(fn func(subNode MlgNodeKind)) {
 	// this doesn't have any sub nodes
 }
 
+func (n *ErrorGroup) ForEach(fn func(subNode MlgNodeKind)) {
+	// this doesn't have any sub nodes
+}
+
 func (n *NameForm) ForEach(fn func(subNode MlgNodeKind)) {
 	// this doesn't have any sub nodes
 }
@@ -18,6 +18,9 @@ package ast
 
 type FormulationMetaData struct {
 	Original FormulationNodeKind
+	// for an operator call, the signature of the Describes: or Defines: that
+	// provides the operator or empty if the operator has not been resolved
+	ResolvedSignature string
 }
 
 func (n *NameForm) GetFormulationMetaData() *FormulationMetaData {
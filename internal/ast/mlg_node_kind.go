@@ -72,6 +72,8 @@ func (*PersonGroup) MlgNodeKind()                            {}
 func (*NameGroup) MlgNodeKind()                              {}
 func (*BiographyGroup) MlgNodeKind()                         {}
 func (*ResourceGroup) MlgNodeKind()                          {}
+func (*TopicGroup) MlgNodeKind()                             {}
+func (*NoteGroup) MlgNodeKind()                              {}
 func (*TitleGroup) MlgNodeKind()                             {}
 func (*AuthorGroup) MlgNodeKind()                            {}
 func (*OffsetGroup) MlgNodeKind()                            {}
@@ -181,6 +183,7 @@ func (*InductivelyGroup) MlgNodeKind()                       {}
 func (*InductivelyCaseGroup) MlgNodeKind()                   {}
 func (*MatchingGroup) MlgNodeKind()                          {}
 func (*MatchingCaseGroup) MlgNodeKind()                      {}
+func (*ErrorGroup) MlgNodeKind()                             {}
 func (*AbstractBuiltinExpression) MlgNodeKind()              {}
 func (*SpecificationBuiltinExpression) MlgNodeKind()         {}
 func (*StatementBuiltinExpression) MlgNodeKind()             {}
@@ -150,6 +150,7 @@ const LowerNameName = "name"
 const LowerBiographyName = "biography"
 const UpperPersonName = "Person"
 const UpperResourceName = "Resource"
+const UpperTopicName = "Topic"
 const UpperIdName = "Id"
 const UpperIdQuestionName = UpperIdName + "?"
 const UpperCorollaryName = "Corollary"
@@ -190,3 +191,7 @@ const LowerMatchingName = "matching"
 const LowerAgainstName = "against"
 const LowerTracksName = "tracks"
 const LowerTracksQuestionName = LowerTracksName + "?"
+const LowerPrecedenceName = "precedence"
+const LowerPrecedenceQuestionName = LowerPrecedenceName + "?"
+const LowerAssociativityName = "associativity"
+const LowerAssociativityQuestionName = LowerAssociativityName + "?"
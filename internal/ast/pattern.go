@@ -21,6 +21,11 @@ type StructuralColonEqualsPattern struct {
 	Rhs PatternKind
 }
 
+type StructuralColonEqualsColonPattern struct {
+	Lhs FormPatternKind
+	Rhs FormPatternKind
+}
+
 type NameFormPattern struct {
 	Text            string
 	IsStropped      bool
@@ -23,27 +23,28 @@ type PatternKind interface {
 	GetVarArgData() VarArgPatternData
 }
 
-func (*NameFormPattern) PatternKind()                 {}
-func (*SymbolFormPattern) PatternKind()               {}
-func (*FunctionFormPattern) PatternKind()             {}
-func (*ExpressionFormPattern) PatternKind()           {}
-func (*TupleFormPattern) PatternKind()                {}
-func (*ConditionalSetExpressionPattern) PatternKind() {}
-func (*ConditionalSetFormPattern) PatternKind()       {}
-func (*ConditionalSetIdFormPattern) PatternKind()     {}
-func (*FunctionLiteralFormPattern) PatternKind()      {}
-func (*InfixOperatorFormPattern) PatternKind()        {}
-func (*PrefixOperatorFormPattern) PatternKind()       {}
-func (*PostfixOperatorFormPattern) PatternKind()      {}
-func (*OrdinalPattern) PatternKind()                  {}
-func (*StructuralColonEqualsPattern) PatternKind()    {}
-func (*InfixCommandOperatorPattern) PatternKind()     {}
-func (*InfixCommandPattern) PatternKind()             {}
-func (*CommandPattern) PatternKind()                  {}
-func (*NamedGroupPattern) PatternKind()               {}
-func (*ChainExpressionPattern) PatternKind()          {}
-func (*SpecAliasPattern) PatternKind()                {}
-func (*AliasPattern) PatternKind()                    {}
+func (*NameFormPattern) PatternKind()                   {}
+func (*SymbolFormPattern) PatternKind()                 {}
+func (*FunctionFormPattern) PatternKind()               {}
+func (*ExpressionFormPattern) PatternKind()             {}
+func (*TupleFormPattern) PatternKind()                  {}
+func (*ConditionalSetExpressionPattern) PatternKind()   {}
+func (*ConditionalSetFormPattern) PatternKind()         {}
+func (*ConditionalSetIdFormPattern) PatternKind()       {}
+func (*FunctionLiteralFormPattern) PatternKind()        {}
+func (*InfixOperatorFormPattern) PatternKind()          {}
+func (*PrefixOperatorFormPattern) PatternKind()         {}
+func (*PostfixOperatorFormPattern) PatternKind()        {}
+func (*OrdinalPattern) PatternKind()                    {}
+func (*StructuralColonEqualsPattern) PatternKind()      {}
+func (*StructuralColonEqualsColonPattern) PatternKind() {}
+func (*InfixCommandOperatorPattern) PatternKind()       {}
+func (*InfixCommandPattern) PatternKind()               {}
+func (*CommandPattern) PatternKind()                    {}
+func (*NamedGroupPattern) PatternKind()                 {}
+func (*ChainExpressionPattern) PatternKind()            {}
+func (*SpecAliasPattern) PatternKind()                  {}
+func (*AliasPattern) PatternKind()                      {}
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
@@ -52,18 +53,19 @@ type FormPatternKind interface {
 	FormPatternKind()
 }
 
-func (*NameFormPattern) FormPatternKind()              {}
-func (*SymbolFormPattern) FormPatternKind()            {}
-func (*FunctionFormPattern) FormPatternKind()          {}
-func (*ExpressionFormPattern) FormPatternKind()        {}
-func (*TupleFormPattern) FormPatternKind()             {}
-func (*ConditionalSetFormPattern) FormPatternKind()    {}
-func (*ConditionalSetIdFormPattern) FormPatternKind()  {}
-func (*FunctionLiteralFormPattern) FormPatternKind()   {}
-func (*InfixOperatorFormPattern) FormPatternKind()     {}
-func (*PrefixOperatorFormPattern) FormPatternKind()    {}
-func (*PostfixOperatorFormPattern) FormPatternKind()   {}
-func (*StructuralColonEqualsPattern) FormPatternKind() {}
+func (*NameFormPattern) FormPatternKind()                   {}
+func (*SymbolFormPattern) FormPatternKind()                 {}
+func (*FunctionFormPattern) FormPatternKind()               {}
+func (*ExpressionFormPattern) FormPatternKind()             {}
+func (*TupleFormPattern) FormPatternKind()                  {}
+func (*ConditionalSetFormPattern) FormPatternKind()         {}
+func (*ConditionalSetIdFormPattern) FormPatternKind()       {}
+func (*FunctionLiteralFormPattern) FormPatternKind()        {}
+func (*InfixOperatorFormPattern) FormPatternKind()          {}
+func (*PrefixOperatorFormPattern) FormPatternKind()         {}
+func (*PostfixOperatorFormPattern) FormPatternKind()        {}
+func (*StructuralColonEqualsPattern) FormPatternKind()      {}
+func (*StructuralColonEqualsColonPattern) FormPatternKind() {}
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
@@ -38,6 +38,9 @@ func (p *OrdinalPattern) GetVarArgData() VarArgPatternData { return VarArgPatter
 func (p *StructuralColonEqualsPattern) GetVarArgData() VarArgPatternData {
 	return VarArgPatternData{}
 }
+func (p *StructuralColonEqualsColonPattern) GetVarArgData() VarArgPatternData {
+	return VarArgPatternData{}
+}
 func (p *InfixCommandOperatorPattern) GetVarArgData() VarArgPatternData {
 	return VarArgPatternData{}
 }
@@ -62,6 +62,7 @@ var all_group_test_cases = []testCase{
 			"Documented?",
 			"References?",
 			"Aliases?",
+			"Viewable?",
 			"Id?",
 		},
 	},
@@ -90,6 +91,7 @@ var all_group_test_cases = []testCase{
 			"Justified?",
 			"Documented?",
 			"References?",
+			"Viewable?",
 			"Id?",
 		},
 	},
@@ -108,6 +110,7 @@ var all_group_test_cases = []testCase{
 			"Documented?",
 			"References?",
 			"Aliases?",
+			"Viewable?",
 			"Id?",
 		},
 	},
@@ -128,6 +131,7 @@ var all_group_test_cases = []testCase{
 			"Documented?",
 			"References?",
 			"Aliases?",
+			"Viewable?",
 			"Id?",
 		},
 	},
@@ -147,6 +151,7 @@ var all_group_test_cases = []testCase{
 			"Documented?",
 			"References?",
 			"Aliases?",
+			"Viewable?",
 			"Id?",
 		},
 	},
@@ -166,6 +171,7 @@ var all_group_test_cases = []testCase{
 			"Documented?",
 			"References?",
 			"Aliases?",
+			"Viewable?",
 			"Id?",
 		},
 	},
@@ -293,6 +299,7 @@ var all_group_test_cases = []testCase{
 			"Documented?",
 			"References?",
 			"Aliases?",
+			"Viewable?",
 			"Id?",
 		},
 	},
@@ -569,6 +576,27 @@ var all_group_test_cases = []testCase{
 			"Id?",
 		},
 	},
+	{
+		actual: TopicSections,
+		expected: []string{
+			"Topic",
+			"content",
+			"members",
+			"Documented?",
+			"References?",
+			"Viewable?",
+			"Id?",
+		},
+	},
+	{
+		actual: NoteSections,
+		expected: []string{
+			"Note",
+			"on?",
+			"Viewable?",
+			"Id?",
+		},
+	},
 	{
 		actual: SpecifySections,
 		expected: []string{
@@ -589,6 +617,7 @@ var all_group_test_cases = []testCase{
 			"Documented?",
 			"References?",
 			"Aliases?",
+			"Viewable?",
 			"Id?",
 		},
 	},
@@ -606,6 +635,8 @@ var all_group_test_cases = []testCase{
 			"tracks?",
 			"replaces?",
 			"written?",
+			"precedence?",
+			"associativity?",
 		},
 	},
 	{
@@ -624,6 +655,7 @@ var all_group_test_cases = []testCase{
 			"Documented?",
 			"References?",
 			"Aliases?",
+			"Viewable?",
 			"Id?",
 		},
 	},
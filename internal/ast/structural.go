@@ -487,6 +487,8 @@ var SymbolWrittenSections = []string{
 	LowerTracksQuestionName,
 	LowerReplacesQuestionName,
 	LowerWrittenQuestionName,
+	LowerPrecedenceQuestionName,
+	LowerAssociativityQuestionName,
 }
 
 type SymbolWrittenGroup struct {
@@ -495,6 +497,8 @@ type SymbolWrittenGroup struct {
 	Tracks         *TracksSection
 	Replaces       *ReplacesSection
 	Written        *WrittenSection
+	Precedence     *PrecedenceSection
+	Associativity  *AssociativitySection
 	CommonMetaData CommonMetaData
 }
 
@@ -518,6 +522,18 @@ type WrittenSection struct {
 	CommonMetaData CommonMetaData
 }
 
+// precedence: "7"
+type PrecedenceSection struct {
+	Precedence     TextItem
+	CommonMetaData CommonMetaData
+}
+
+// associativity: "left" or associativity: "right"
+type AssociativitySection struct {
+	Associativity  TextItem
+	CommonMetaData CommonMetaData
+}
+
 var ViewSections = []string{
 	LowerViewName,
 	LowerAsName,
@@ -718,6 +734,7 @@ var DescribesSections = []string{
 	UpperDocumentedQuestionName,
 	UpperReferencesQuestionName,
 	UpperAliasesQuestionName,
+	UpperViewableQuestionName,
 	UpperIdQuestionName,
 }
 
@@ -736,6 +753,7 @@ type DescribesGroup struct {
 	Documented     *DocumentedSection
 	References     *ReferencesSection
 	Aliases        *AliasesSection
+	Viewable       *ViewableSection
 	MetaId         *MetaIdSection
 	CommonMetaData CommonMetaData
 }
@@ -784,6 +802,15 @@ type MetaIdSection struct {
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// ViewableSection controls whether an entry is shown by `mlg view`.  An entry with
+// `Viewable?: "false"` is still checked but is not shown, which is useful for drafts.
+type ViewableSection struct {
+	Viewable       TextItem
+	CommonMetaData CommonMetaData
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
 var DefinesSections = []string{
 	UpperDefinesName,
 	LowerUsingQuestionName,
@@ -798,6 +825,7 @@ var DefinesSections = []string{
 	UpperDocumentedQuestionName,
 	UpperReferencesQuestionName,
 	UpperAliasesQuestionName,
+	UpperViewableQuestionName,
 	UpperIdQuestionName,
 }
 
@@ -816,6 +844,7 @@ type DefinesGroup struct {
 	Documented     *DocumentedSection
 	References     *ReferencesSection
 	Aliases        *AliasesSection
+	Viewable       *ViewableSection
 	MetaId         *MetaIdSection
 	CommonMetaData CommonMetaData
 }
@@ -842,6 +871,7 @@ var CapturesSections = []string{
 	UpperJustifiedQuestionName,
 	UpperDocumentedQuestionName,
 	UpperReferencesQuestionName,
+	UpperViewableQuestionName,
 	UpperIdQuestionName,
 }
 
@@ -851,6 +881,7 @@ type CapturesGroup struct {
 	Justified      *JustifiedSection
 	Documented     *DocumentedSection
 	References     *ReferencesSection
+	Viewable       *ViewableSection
 	MetaId         *MetaIdSection
 	CommonMetaData CommonMetaData
 }
@@ -873,6 +904,7 @@ var StatesSections = []string{
 	UpperDocumentedQuestionName,
 	UpperReferencesQuestionName,
 	UpperAliasesQuestionName,
+	UpperViewableQuestionName,
 	UpperIdQuestionName,
 }
 
@@ -888,6 +920,7 @@ type StatesGroup struct {
 	Documented     *DocumentedSection
 	References     *ReferencesSection
 	Aliases        *AliasesSection
+	Viewable       *ViewableSection
 	MetaId         *MetaIdSection
 	CommonMetaData CommonMetaData
 }
@@ -916,6 +949,7 @@ var AxiomSections = []string{
 	UpperDocumentedQuestionName,
 	UpperReferencesQuestionName,
 	UpperAliasesQuestionName,
+	UpperViewableQuestionName,
 	UpperIdQuestionName,
 }
 
@@ -933,6 +967,7 @@ type AxiomGroup struct {
 	Documented     *DocumentedSection
 	References     *ReferencesSection
 	Aliases        *AliasesSection
+	Viewable       *ViewableSection
 	MetaId         *MetaIdSection
 	CommonMetaData CommonMetaData
 }
@@ -961,6 +996,7 @@ var ConjectureSections = []string{
 	UpperDocumentedQuestionName,
 	UpperReferencesQuestionName,
 	UpperAliasesQuestionName,
+	UpperViewableQuestionName,
 	UpperIdQuestionName,
 }
 
@@ -978,6 +1014,7 @@ type ConjectureGroup struct {
 	Documented     *DocumentedSection
 	References     *ReferencesSection
 	Aliases        *AliasesSection
+	Viewable       *ViewableSection
 	MetaId         *MetaIdSection
 	CommonMetaData CommonMetaData
 }
@@ -1002,6 +1039,7 @@ var TheoremSections = []string{
 	UpperDocumentedQuestionName,
 	UpperReferencesQuestionName,
 	UpperAliasesQuestionName,
+	UpperViewableQuestionName,
 	UpperIdQuestionName,
 }
 
@@ -1020,6 +1058,7 @@ type TheoremGroup struct {
 	Documented     *DocumentedSection
 	References     *ReferencesSection
 	Aliases        *AliasesSection
+	Viewable       *ViewableSection
 	MetaId         *MetaIdSection
 	CommonMetaData CommonMetaData
 }
@@ -1050,6 +1089,7 @@ var LemmaSections = []string{
 	UpperDocumentedQuestionName,
 	UpperReferencesQuestionName,
 	UpperAliasesQuestionName,
+	UpperViewableQuestionName,
 	UpperIdQuestionName,
 }
 
@@ -1069,6 +1109,7 @@ type LemmaGroup struct {
 	Documented     *DocumentedSection
 	References     *ReferencesSection
 	Aliases        *AliasesSection
+	Viewable       *ViewableSection
 	MetaId         *MetaIdSection
 	CommonMetaData CommonMetaData
 }
@@ -1099,6 +1140,7 @@ var CorollarySections = []string{
 	UpperDocumentedQuestionName,
 	UpperReferencesQuestionName,
 	UpperAliasesQuestionName,
+	UpperViewableQuestionName,
 	UpperIdQuestionName,
 }
 
@@ -1118,6 +1160,7 @@ type CorollaryGroup struct {
 	Documented     *DocumentedSection
 	References     *ReferencesSection
 	Aliases        *AliasesSection
+	Viewable       *ViewableSection
 	MetaId         *MetaIdSection
 	CommonMetaData CommonMetaData
 }
@@ -1261,6 +1304,76 @@ type BiographySection struct {
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
+var TopicSections = []string{
+	UpperTopicName,
+	LowerContentName,
+	LowerMembersName,
+	UpperDocumentedQuestionName,
+	UpperReferencesQuestionName,
+	UpperViewableQuestionName,
+	UpperIdQuestionName,
+}
+
+// TopicGroup describes a curated list of entries, identified by their signatures, that
+// can be read together independent of the files the entries are in.
+type TopicGroup struct {
+	Id             string
+	Topic          TopicSection
+	Content        TopicContentSection
+	Members        TopicMembersSection
+	Documented     *DocumentedSection
+	References     *ReferencesSection
+	Viewable       *ViewableSection
+	MetaId         *MetaIdSection
+	CommonMetaData CommonMetaData
+}
+
+type TopicSection struct {
+	Name           TextItem
+	CommonMetaData CommonMetaData
+}
+
+type TopicContentSection struct {
+	Content        TextItem
+	CommonMetaData CommonMetaData
+}
+
+type TopicMembersSection struct {
+	Members        []Formulation[FormulationNodeKind]
+	CommonMetaData CommonMetaData
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+var NoteSections = []string{
+	UpperNoteName,
+	LowerOnQuestionName,
+	UpperViewableQuestionName,
+	UpperIdQuestionName,
+}
+
+// NoteGroup describes free-form commentary that, if it has an `on:` section, is about the
+// entry with the given signature.
+type NoteGroup struct {
+	Note           NoteSection
+	On             *NoteOnSection
+	Viewable       *ViewableSection
+	MetaId         *MetaIdSection
+	CommonMetaData CommonMetaData
+}
+
+type NoteSection struct {
+	Note           TextItem
+	CommonMetaData CommonMetaData
+}
+
+type NoteOnSection struct {
+	On             Formulation[FormulationNodeKind]
+	CommonMetaData CommonMetaData
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
 var ResourceSections = []string{UpperResourceName, UpperIdQuestionName}
 
 type ResourceGroup struct {
@@ -1469,6 +1582,16 @@ type Document struct {
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// ErrorGroup is used in place of a group that could not be parsed so that the
+// items around it can still be processed.
+type ErrorGroup struct {
+	// the names of the group's sections in the order they were specified
+	SectionNames   []string
+	CommonMetaData CommonMetaData
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
 var ProofThenSections = []string{
 	LowerThenName,
 	LowerByQuestionName,
@@ -71,6 +71,8 @@ func (*PersonGroup) StructuralNodeKind()                       {}
 func (*NameGroup) StructuralNodeKind()                         {}
 func (*BiographyGroup) StructuralNodeKind()                    {}
 func (*ResourceGroup) StructuralNodeKind()                     {}
+func (*TopicGroup) StructuralNodeKind()                        {}
+func (*NoteGroup) StructuralNodeKind()                         {}
 func (*TitleGroup) StructuralNodeKind()                        {}
 func (*AuthorGroup) StructuralNodeKind()                       {}
 func (*OffsetGroup) StructuralNodeKind()                       {}
@@ -128,6 +130,7 @@ func (*InductivelyGroup) StructuralNodeKind()                  {}
 func (*InductivelyCaseGroup) StructuralNodeKind()              {}
 func (*MatchingGroup) StructuralNodeKind()                     {}
 func (*MatchingCaseGroup) StructuralNodeKind()                 {}
+func (*ErrorGroup) StructuralNodeKind()                        {}
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
@@ -153,6 +156,7 @@ func (*PiecewiseGroup) ClauseKind()        {}
 func (*DeclareGroup) ClauseKind()          {}
 func (*InductivelyGroup) ClauseKind()      {}
 func (*MatchingGroup) ClauseKind()         {}
+func (*ErrorGroup) ClauseKind()            {}
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
@@ -254,7 +258,10 @@ func (*LemmaGroup) TopLevelItemKind()      {}
 func (*SpecifyGroup) TopLevelItemKind()    {}
 func (*PersonGroup) TopLevelItemKind()     {}
 func (*ResourceGroup) TopLevelItemKind()   {}
+func (*TopicGroup) TopLevelItemKind()      {}
+func (*NoteGroup) TopLevelItemKind()       {}
 func (*CapturesGroup) TopLevelItemKind()   {}
+func (*ErrorGroup) TopLevelItemKind()      {}
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
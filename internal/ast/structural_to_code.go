@@ -207,6 +207,13 @@ func (n *SymbolWrittenGroup) ToCode(indent int, hasDot bool) []string {
 	if n.Written != nil {
 		db.AppendTextItemsSection(LowerWrittenName, n.Written.Written, indent, true)
 	}
+	if n.Precedence != nil {
+		db.AppendSingleTextItemSection(LowerPrecedenceName, n.Precedence.Precedence, indent, true)
+	}
+	if n.Associativity != nil {
+		db.AppendSingleTextItemSection(
+			LowerAssociativityName, n.Associativity.Associativity, indent, true)
+	}
 	return db.Lines()
 }
 
@@ -330,6 +337,7 @@ func (n *DescribesGroup) ToCode(indent int, hasDot bool) []string {
 	db.MaybeAppendDocumentedSection(n.Documented, indent, false)
 	db.MaybeAppendReferencesSection(n.References, indent, false)
 	db.MaybeAppendAliasesSection(n.Aliases, indent, false)
+	db.MaybeAppendViewableSection(n.Viewable, indent, false)
 	db.MaybeAppendMetaIdSection(n.MetaId, indent, false)
 	return db.Lines()
 }
@@ -369,6 +377,7 @@ func (n *DefinesGroup) ToCode(indent int, hasDot bool) []string {
 	db.MaybeAppendDocumentedSection(n.Documented, indent, false)
 	db.MaybeAppendReferencesSection(n.References, indent, false)
 	db.MaybeAppendAliasesSection(n.Aliases, indent, false)
+	db.MaybeAppendViewableSection(n.Viewable, indent, false)
 	db.MaybeAppendMetaIdSection(n.MetaId, indent, false)
 	return db.Lines()
 }
@@ -385,6 +394,7 @@ func (n *CapturesGroup) ToCode(indent int, hasDot bool) []string {
 	}
 	db.MaybeAppendDocumentedSection(n.Documented, indent, false)
 	db.MaybeAppendReferencesSection(n.References, indent, false)
+	db.MaybeAppendViewableSection(n.Viewable, indent, false)
 	db.MaybeAppendMetaIdSection(n.MetaId, indent, false)
 	return db.Lines()
 }
@@ -411,6 +421,7 @@ func (n *StatesGroup) ToCode(indent int, hasDot bool) []string {
 	db.MaybeAppendDocumentedSection(n.Documented, indent, false)
 	db.MaybeAppendReferencesSection(n.References, indent, false)
 	db.MaybeAppendAliasesSection(n.Aliases, indent, false)
+	db.MaybeAppendViewableSection(n.Viewable, indent, false)
 	db.MaybeAppendMetaIdSection(n.MetaId, indent, false)
 	return db.Lines()
 }
@@ -430,6 +441,7 @@ func (n *AxiomGroup) ToCode(indent int, hasDot bool) []string {
 	db.MaybeAppendDocumentedSection(n.Documented, indent, false)
 	db.MaybeAppendReferencesSection(n.References, indent, false)
 	db.MaybeAppendAliasesSection(n.Aliases, indent, false)
+	db.MaybeAppendViewableSection(n.Viewable, indent, false)
 	db.MaybeAppendMetaIdSection(n.MetaId, indent, false)
 	return db.Lines()
 }
@@ -449,6 +461,7 @@ func (n *ConjectureGroup) ToCode(indent int, hasDot bool) []string {
 	db.MaybeAppendDocumentedSection(n.Documented, indent, false)
 	db.MaybeAppendReferencesSection(n.References, indent, false)
 	db.MaybeAppendAliasesSection(n.Aliases, indent, false)
+	db.MaybeAppendViewableSection(n.Viewable, indent, false)
 	db.MaybeAppendMetaIdSection(n.MetaId, indent, false)
 	return db.Lines()
 }
@@ -471,6 +484,7 @@ func (n *TheoremGroup) ToCode(indent int, hasDot bool) []string {
 	db.MaybeAppendDocumentedSection(n.Documented, indent, false)
 	db.MaybeAppendReferencesSection(n.References, indent, false)
 	db.MaybeAppendAliasesSection(n.Aliases, indent, false)
+	db.MaybeAppendViewableSection(n.Viewable, indent, false)
 	db.MaybeAppendMetaIdSection(n.MetaId, indent, false)
 	return db.Lines()
 }
@@ -494,6 +508,7 @@ func (n *CorollaryGroup) ToCode(indent int, hasDot bool) []string {
 	db.MaybeAppendDocumentedSection(n.Documented, indent, false)
 	db.MaybeAppendReferencesSection(n.References, indent, false)
 	db.MaybeAppendAliasesSection(n.Aliases, indent, false)
+	db.MaybeAppendViewableSection(n.Viewable, indent, false)
 	db.MaybeAppendMetaIdSection(n.MetaId, indent, false)
 	return db.Lines()
 }
@@ -517,6 +532,7 @@ func (n *LemmaGroup) ToCode(indent int, hasDot bool) []string {
 	db.MaybeAppendDocumentedSection(n.Documented, indent, false)
 	db.MaybeAppendReferencesSection(n.References, indent, false)
 	db.MaybeAppendAliasesSection(n.Aliases, indent, false)
+	db.MaybeAppendViewableSection(n.Viewable, indent, false)
 	db.MaybeAppendMetaIdSection(n.MetaId, indent, false)
 	return db.Lines()
 }
@@ -601,6 +617,30 @@ func (n *ResourceGroup) ToCode(indent int, hasDot bool) []string {
 	return db.Lines()
 }
 
+func (n *TopicGroup) ToCode(indent int, hasDot bool) []string {
+	db := newDebugBuilder()
+	db.AppendString(n.Id, indent, hasDot)
+	db.AppendSingleTextItemSection(UpperTopicName, n.Topic.Name, indent, false)
+	db.AppendSingleTextItemSection(LowerContentName, n.Content.Content, indent, false)
+	db.AppendFormulationsSection(LowerMembersName, n.Members.Members, indent, false)
+	db.MaybeAppendDocumentedSection(n.Documented, indent, false)
+	db.MaybeAppendReferencesSection(n.References, indent, false)
+	db.MaybeAppendViewableSection(n.Viewable, indent, false)
+	db.MaybeAppendMetaIdSection(n.MetaId, indent, false)
+	return db.Lines()
+}
+
+func (n *NoteGroup) ToCode(indent int, hasDot bool) []string {
+	db := newDebugBuilder()
+	db.AppendSingleTextItemSection(UpperNoteName, n.Note.Note, indent, hasDot)
+	if n.On != nil {
+		db.AppendFormulationSection(LowerOnName, n.On.On, indent, false)
+	}
+	db.MaybeAppendViewableSection(n.Viewable, indent, false)
+	db.MaybeAppendMetaIdSection(n.MetaId, indent, false)
+	return db.Lines()
+}
+
 func (n *TitleGroup) ToCode(indent int, hasDot bool) []string {
 	db := newDebugBuilder()
 	db.AppendSection(LowerTitleName, indent, hasDot)
@@ -715,6 +755,11 @@ func (n *TextBlockItem) ToCode(indent int, hasDot bool) []string {
 	return buildIndentedLineSlice(indent, hasDot, "::"+n.Text+"::")
 }
 
+func (n *ErrorGroup) ToCode(indent int, hasDot bool) []string {
+	return buildIndentedLineSlice(indent, hasDot,
+		"<error "+strings.Join(n.SectionNames, ": ")+":>")
+}
+
 func (n *ProofThenGroup) ToCode(indent int, hasDot bool) []string {
 	db := newDebugBuilder()
 	db.MaybeAppendGroupLabel(n.Label, indent, hasDot)
@@ -1245,6 +1290,13 @@ func (db *debugBuilder) MaybeAppendMetaIdSection(sec *MetaIdSection, indent int,
 	}
 }
 
+func (db *debugBuilder) MaybeAppendViewableSection(sec *ViewableSection, indent int, hasDot bool) {
+	if sec != nil {
+		db.AppendSection(UpperViewableName, indent, hasDot)
+		db.Append(&sec.Viewable, indent+2, true)
+	}
+}
+
 func (db *debugBuilder) MaybeAppendDocumentedSection(
 	sec *DocumentedSection, indent int, hasDot bool) {
 	if sec != nil {
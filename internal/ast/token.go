@@ -78,6 +78,7 @@ const (
 	BarRSquare            TokenType = "BarRSquare"
 	LSquareBar            TokenType = "LSquareBar"
 	DollarSign            TokenType = "DollarSign"
+	Comment               TokenType = "Comment"
 )
 
 type Token struct {
@@ -0,0 +1,31 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+// ProvidedOperatorSummary describes an operator, for example `x + y`, provided
+// through the Provides: section of a Describes: or Defines:.
+type ProvidedOperatorSummary struct {
+	Symbol  Alias
+	Written []WrittenSummary
+}
+
+type TypeSummary struct {
+	// the signatures of the types described in the `extends:` section of a Describes: or
+	// the `means:` section of a Defines:
+	Is        []string
+	Operators []ProvidedOperatorSummary
+}
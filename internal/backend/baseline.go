@@ -0,0 +1,127 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"os"
+)
+
+// BaselineEntry identifies a diagnostic independent of its row and column so that it
+// still identifies the diagnostic after unrelated edits to the file containing it.
+type BaselineEntry struct {
+	Path ast.Path
+	// the Id: of the top-level entry containing the diagnostic or
+	// empty if the diagnostic is not within a top-level entry
+	EntryId     string
+	Code        frontend.DiagnosticCode
+	Fingerprint string
+}
+
+type Baseline struct {
+	Entries []BaselineEntry
+}
+
+func LoadBaseline(path string) (Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Baseline{}, err
+	}
+	var baseline Baseline
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return Baseline{}, fmt.Errorf("Failed to parse baseline %s: %s", path, err)
+	}
+	return baseline, nil
+}
+
+func WriteBaseline(path string, baseline Baseline) error {
+	data, err := json.MarshalIndent(baseline, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// GetBaseline returns the baseline that records the given diagnostics.
+func (w *Workspace) GetBaseline(diagnostics []frontend.Diagnostic) Baseline {
+	entries := make([]BaselineEntry, 0, len(diagnostics))
+	for _, diag := range diagnostics {
+		entries = append(entries, w.getBaselineEntry(diag))
+	}
+	return Baseline{
+		Entries: entries,
+	}
+}
+
+// FilterBaseline returns the diagnostics that are not recorded in the given baseline.  If
+// a baseline records a diagnostic n times, only the first n matching diagnostics are removed.
+func (w *Workspace) FilterBaseline(
+	baseline Baseline,
+	diagnostics []frontend.Diagnostic,
+) []frontend.Diagnostic {
+	remaining := make(map[BaselineEntry]int)
+	for _, entry := range baseline.Entries {
+		remaining[entry]++
+	}
+
+	result := make([]frontend.Diagnostic, 0)
+	for _, diag := range diagnostics {
+		entry := w.getBaselineEntry(diag)
+		if remaining[entry] > 0 {
+			remaining[entry]--
+		} else {
+			result = append(result, diag)
+		}
+	}
+	return result
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (w *Workspace) getBaselineEntry(diag frontend.Diagnostic) BaselineEntry {
+	method := fnv.New32()
+	method.Write([]byte(diag.Message))
+	return BaselineEntry{
+		Path:        diag.Path,
+		EntryId:     w.getEntryIdAt(diag.Path, diag.Position),
+		Code:        diag.Code,
+		Fingerprint: fmt.Sprintf("%d", method.Sum32()),
+	}
+}
+
+func (w *Workspace) getEntryIdAt(path ast.Path, position ast.Position) string {
+	doc, ok := w.nodeTracker.phase4Root.Documents[path]
+	if !ok {
+		return ""
+	}
+	id := ""
+	for _, node := range doc.Nodes {
+		if node.Start().Row > position.Row {
+			break
+		}
+		if nodeId, ok := GetPhase4MetaId(node); ok {
+			id = nodeId
+		} else {
+			id = ""
+		}
+	}
+	return id
+}
@@ -0,0 +1,647 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/formulation"
+	"mathlingua/internal/frontend/structural/phase4"
+	"mathlingua/internal/frontend/structural/phase5"
+	"mathlingua/internal/mlglib"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// TextEdit replaces the text from Start (inclusive) to End (exclusive) with NewText where
+// the offsets of the positions are byte offsets and the rows and columns are zero based.
+type TextEdit struct {
+	Start   ast.Position
+	End     ast.Position
+	NewText string
+}
+
+// CodeAction describes how to fix the problem described by a diagnostic.
+type CodeAction struct {
+	Title      string
+	Diagnostic frontend.Diagnostic
+	Edits      []TextEdit
+	// whether the edits can be applied without review, that is they do not remove any
+	// content or guess at what the author meant
+	IsSafe bool
+}
+
+// CodeActions returns the fixes available for the document with the given path.
+func (w *Workspace) CodeActions(path ast.Path) []CodeAction {
+	content, ok := w.getContent(path)
+	if !ok {
+		return []CodeAction{}
+	}
+	doc, _ := w.nodeTracker.GetDocumentAt(path)
+	c := codeActionBuilder{
+		workspace: w,
+		path:      path,
+		text:      newTextLines(content),
+		result:    make([]CodeAction, 0),
+	}
+	for _, node := range doc.Nodes {
+		if group, ok := node.(*phase4.Group); ok {
+			c.addMissingIdAction(group)
+			c.addMissingDocumentedAction(group)
+			c.forEachGroup(group, func(group *phase4.Group) {
+				c.addGivenIfConflictAction(group)
+				c.addReorderSectionsAction(group)
+			})
+		}
+	}
+	if astDoc, ok := w.nodeTracker.astRoot.Documents[path]; ok {
+		for _, item := range astDoc.Items {
+			c.addUnknownSignatureActions(item)
+		}
+	}
+	sort.SliceStable(c.result, func(i, j int) bool {
+		return c.result[i].Edits[0].Start.Offset < c.result[j].Edits[0].Start.Offset
+	})
+	return c.result
+}
+
+// ApplyEdits returns the given text with the given edits applied.  An edit that overlaps
+// an edit earlier in the text is skipped.
+func ApplyEdits(text string, edits []TextEdit) string {
+	sorted := make([]TextEdit, len(edits))
+	copy(sorted, edits)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Start.Offset < sorted[j].Start.Offset
+	})
+
+	result := strings.Builder{}
+	offset := 0
+	for _, edit := range sorted {
+		if edit.Start.Offset < offset || edit.End.Offset < edit.Start.Offset ||
+			edit.End.Offset > len(text) {
+			continue
+		}
+		result.WriteString(text[offset:edit.Start.Offset])
+		result.WriteString(edit.NewText)
+		offset = edit.End.Offset
+	}
+	result.WriteString(text[offset:])
+	return result.String()
+}
+
+// FixFiles applies the safe code actions, or all code actions if includeUnsafe is true, to
+// the Mathlingua files at the given paths in place and returns the paths of the files that
+// were changed.
+func FixFiles(
+	paths []string,
+	includeUnsafe bool,
+	unicodeTable *formulation.UnicodeTable,
+) ([]ast.Path, []frontend.Diagnostic) {
+	changed := make([]ast.Path, 0)
+	diagnostics := make([]frontend.Diagnostic, 0)
+	seen := make(map[ast.Path]bool)
+	// applying an action can make other actions available, for example after sections are
+	// reordered, and so the files are fixed until nothing changes
+	for pass := 0; pass < maxFixPasses; pass++ {
+		workspace, loadDiagnostics := NewWorkspaceFromPaths(paths,
+			frontend.NewDiagnosticTracker(), unicodeTable)
+		if pass == 0 {
+			diagnostics = append(diagnostics, loadDiagnostics...)
+		}
+		changedInPass := false
+		for _, pair := range workspace.Paths() {
+			content, ok := workspace.getContent(pair.Path)
+			if !ok {
+				continue
+			}
+			edits := make([]TextEdit, 0)
+			for _, action := range workspace.CodeActions(pair.Path) {
+				if action.IsSafe || includeUnsafe {
+					edits = append(edits, action.Edits...)
+				}
+			}
+			fixed := ApplyEdits(content, edits)
+			if fixed == content {
+				continue
+			}
+			if err := os.WriteFile(string(pair.Path), []byte(fixed), 0644); err != nil {
+				diagnostics = append(diagnostics, frontend.Diagnostic{
+					Type:    frontend.Error,
+					Origin:  frontend.CliOrigin,
+					Code:    frontend.FileSystemErrorCode,
+					Path:    pair.Path,
+					Message: err.Error(),
+				})
+				return changed, diagnostics
+			}
+			changedInPass = true
+			if !seen[pair.Path] {
+				seen[pair.Path] = true
+				changed = append(changed, pair.Path)
+			}
+		}
+		if !changedInPass {
+			break
+		}
+	}
+	return changed, diagnostics
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const maxFixPasses = 10
+
+const metaIdSeparator = "------------------------------------------"
+
+// newMetaId returns the id used for an inserted Id: section
+var newMetaId = func() string {
+	id, _ := uuid.NewRandom()
+	return id.String()
+}
+
+type codeActionBuilder struct {
+	workspace *Workspace
+	path      ast.Path
+	text      textLines
+	result    []CodeAction
+}
+
+func (c *codeActionBuilder) forEachGroup(group *phase4.Group, fn func(group *phase4.Group)) {
+	fn(group)
+	for _, section := range group.Sections {
+		for _, arg := range section.Args {
+			if argGroup, ok := arg.Arg.(*phase4.Group); ok {
+				c.forEachGroup(argGroup, fn)
+			}
+		}
+	}
+}
+
+func (c *codeActionBuilder) insert(offset int, text string) TextEdit {
+	return c.replace(offset, offset, text)
+}
+
+func (c *codeActionBuilder) replace(start int, end int, text string) TextEdit {
+	return TextEdit{
+		Start:   c.text.positionAt(start),
+		End:     c.text.positionAt(end),
+		NewText: text,
+	}
+}
+
+func (c *codeActionBuilder) addMissingIdAction(group *phase4.Group) {
+	if !isMissingId(group) {
+		return
+	}
+
+	startRow := c.text.rowAt(group.Sections[0].MetaData.Start.Offset)
+	endRow := c.text.getBlockEndRow(startRow)
+	newText := "\n"
+	if !c.text.hasCommentBetween(startRow, endRow) {
+		newText += metaIdSeparator + "\n"
+	}
+	newText += fmt.Sprintf("Id: \"%s\"", newMetaId())
+
+	c.result = append(c.result, CodeAction{
+		Title:      "Insert an Id: section",
+		Diagnostic: newMissingIdDiagnostic(c.path, group),
+		Edits:      []TextEdit{c.insert(c.text.lineEnd(endRow), newText)},
+		IsSafe:     true,
+	})
+}
+
+func (c *codeActionBuilder) addMissingDocumentedAction(group *phase4.Group) {
+	if len(group.Sections) == 0 || findSection(group, ast.UpperDocumentedName) != nil {
+		return
+	}
+	name := group.Sections[0].Name
+	if name != ast.UpperDefinesName && name != ast.UpperDescribesName &&
+		name != ast.UpperStatesName && name != ast.UpperCapturesName {
+		return
+	}
+	pattern := getSectionPattern(group, topLevelSectionPatterns)
+	item := c.getAstItem(group)
+	if pattern == nil || item == nil {
+		return
+	}
+	sig, ok := GetSignatureStringFromTopLevel(item)
+	if !ok {
+		return
+	}
+
+	skeleton := fmt.Sprintf("Documented:\n. written: \"\\textrm{%s}\"\n", getSignatureName(sig))
+	var edit TextEdit
+	if next := getFirstSectionAfter(group, pattern, ast.UpperDocumentedQuestionName); next != nil {
+		edit = c.insert(c.text.getSectionStart(next.MetaData.Start.Offset, 0), skeleton)
+	} else {
+		startRow := c.text.rowAt(group.Sections[0].MetaData.Start.Offset)
+		endRow := c.text.getBlockEndRow(startRow)
+		edit = c.insert(c.text.lineEnd(endRow), "\n"+strings.TrimSuffix(skeleton, "\n"))
+	}
+
+	c.result = append(c.result, CodeAction{
+		Title:      "Add a Documented:written: section",
+		Diagnostic: newMissingWrittenDiagnostic(c.path, sig, group.MetaData.Start),
+		Edits:      []TextEdit{edit},
+		// the written form is a placeholder that the author needs to complete
+		IsSafe: false,
+	})
+}
+
+// addGivenIfConflictAction adds an action to remove the if: section of a group that also has
+// a given: section as reported by checkResultGivenSuchThatIf.
+func (c *codeActionBuilder) addGivenIfConflictAction(group *phase4.Group) {
+	if len(group.Sections) == 0 {
+		return
+	}
+	switch group.Sections[0].Name {
+	case ast.UpperAxiomName, ast.UpperConjectureName, ast.UpperTheoremName,
+		ast.UpperCorollaryName, ast.UpperLemmaName, ast.LowerClaimName:
+	default:
+		return
+	}
+	if findSection(group, ast.LowerGivenName) == nil {
+		return
+	}
+	for i, section := range group.Sections {
+		if section.Name != ast.LowerIfName || i == 0 || i+1 >= len(group.Sections) {
+			continue
+		}
+		start := c.text.lineStart(c.text.rowAt(section.MetaData.Start.Offset))
+		end := c.text.getSectionStart(group.Sections[i+1].MetaData.Start.Offset, start)
+		c.result = append(c.result, CodeAction{
+			Title: "Remove the if: section",
+			Diagnostic: frontend.Diagnostic{
+				Type:     frontend.Error,
+				Origin:   frontend.BackendOrigin,
+				Code:     frontend.InvalidRequirementCode,
+				Message:  givenIfConflictMessage,
+				Path:     c.path,
+				Position: group.MetaData.Start,
+			},
+			Edits: []TextEdit{c.replace(start, end, "")},
+			// removing the section removes its content
+			IsSafe: false,
+		})
+	}
+}
+
+func (c *codeActionBuilder) addReorderSectionsAction(group *phase4.Group) {
+	patterns := append(append([][]string{}, topLevelSectionPatterns...),
+		nestedSectionPatterns...)
+	pattern := getSectionPattern(group, patterns)
+	if pattern == nil || len(group.Sections) < 3 {
+		return
+	}
+	// the first section names the group and so always stays first
+	order, ok := phase5.CanonicalSectionOrder(group.Sections, pattern...)
+	if !ok || order[0] != 0 {
+		return
+	}
+
+	// each section after the first must start on its own line
+	rows := make([]int, 0, len(group.Sections))
+	for _, section := range group.Sections {
+		row := c.text.rowAt(section.MetaData.Start.Offset)
+		if len(rows) > 0 && row <= rows[len(rows)-1] {
+			return
+		}
+		rows = append(rows, row)
+	}
+	starts := []int{c.text.lineStart(rows[0])}
+	for i := 1; i < len(rows); i++ {
+		starts = append(starts, c.text.getSectionStart(
+			group.Sections[i].MetaData.Start.Offset, c.text.lineStart(rows[i-1]+1)))
+	}
+	column := c.text.columnAt(group.Sections[0].MetaData.Start.Offset)
+	end := c.text.lineEnd(c.text.getGroupEndRow(rows[len(rows)-1], column))
+
+	chunks := make([]string, 0, len(order))
+	for _, index := range order[1:] {
+		chunkEnd := end
+		if index+1 < len(starts) {
+			chunkEnd = starts[index+1]
+		}
+		chunk := c.text.text[starts[index]:chunkEnd]
+		if !strings.HasSuffix(chunk, "\n") {
+			chunk += "\n"
+		}
+		chunks = append(chunks, chunk)
+	}
+	newText := strings.TrimSuffix(strings.Join(chunks, ""), "\n")
+
+	c.result = append(c.result, CodeAction{
+		Title:      "Reorder the sections",
+		Diagnostic: phase5.NewSectionOrderDiagnostic(c.path, group.Sections, order),
+		Edits:      []TextEdit{c.replace(starts[1], end, newText)},
+		IsSafe:     true,
+	})
+}
+
+func (c *codeActionBuilder) addUnknownSignatureActions(node ast.MlgNodeKind) {
+	if node == nil {
+		return
+	}
+	if cmd, ok := node.(*ast.CommandExpression); ok {
+		c.addUnknownSignatureAction(GetSignatureStringFromCommand(*cmd),
+			node.GetCommonMetaData().Start)
+	}
+	node.ForEach(func(subNode ast.MlgNodeKind) {
+		switch n := subNode.(type) {
+		case *ast.ExpressionColonArrowItem:
+			c.addUnknownSignatureActions(n.Rhs)
+		default:
+			c.addUnknownSignatureActions(subNode)
+		}
+	})
+}
+
+// addUnknownSignatureAction adds an action to replace the names in the command, with the
+// given unknown signature, at the given position with the names in the most similar known
+// signature.
+func (c *codeActionBuilder) addUnknownSignatureAction(sig string, position ast.Position) {
+	if _, ok := c.workspace.nodeTracker.signaturesToIds[sig]; ok {
+		return
+	}
+	suggestions := c.workspace.signatureManager.GetSuggestions(sig)
+	if len(suggestions) == 0 {
+		return
+	}
+	suggestion := suggestions[0]
+	mainNames, namedGroups, ok := splitSignature(suggestion)
+	if !ok {
+		return
+	}
+
+	// only the line containing the command is needed to find the names in the command
+	start := position.Offset
+	lineEnd := c.text.lineEnd(c.text.rowAt(start))
+	if start < 0 || start >= lineEnd {
+		return
+	}
+	lexer := formulation.NewLexer(c.path, c.text.text[start:lineEnd],
+		frontend.NewDiagnosticTracker(), c.workspace.nodeTracker.unicodeTable)
+	tokens := make([]ast.Token, 0)
+	for lexer.HasNext() {
+		tokens = append(tokens, lexer.Next())
+	}
+	cmd, ok := scanCommand(tokens, 0)
+	if !ok || cmd.signature(tokens) != sig || len(cmd.NamedGroups) != len(namedGroups) {
+		return
+	}
+
+	first := tokens[cmd.MainNames[0]]
+	last := tokens[cmd.MainNames[len(cmd.MainNames)-1]]
+	edits := []TextEdit{
+		c.replace(start+first.Position.Offset, start+last.Position.Offset+len(last.Text),
+			mainNames),
+	}
+	for i, index := range cmd.NamedGroups {
+		token := tokens[index]
+		edits = append(edits, c.replace(start+token.Position.Offset,
+			start+token.Position.Offset+len(token.Text), namedGroups[i]))
+	}
+
+	c.result = append(c.result, CodeAction{
+		Title: fmt.Sprintf("Replace %s with %s", sig, suggestion),
+		Diagnostic: frontend.Diagnostic{
+			Type:        frontend.Error,
+			Origin:      frontend.BackendOrigin,
+			Code:        frontend.UnrecognizedSignatureCode,
+			Message:     fmt.Sprintf("Unrecognized signature %s", sig),
+			Path:        c.path,
+			Position:    position,
+			Suggestions: suggestions,
+		},
+		Edits: edits,
+		// the replacement is a guess unless it is the only similar signature and only
+		// corrects a typo in each name
+		IsSafe: len(suggestions) == 1 && isTypoOf(sig, suggestion),
+	})
+}
+
+// isTypoOf returns whether the given signatures have the same number of segments and each
+// segment of one is at most one edit from the corresponding segment of the other, as in
+// `\:functin:on` and `\:function:on`.
+func isTypoOf(sig string, other string) bool {
+	segments := getSignatureSegments(sig)
+	otherSegments := getSignatureSegments(other)
+	if len(segments) != len(otherSegments) {
+		return false
+	}
+	for i := range segments {
+		if mlglib.EditDistance(segments[i], otherSegments[i]) > 1 {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *codeActionBuilder) getAstItem(group *phase4.Group) ast.TopLevelItemKind {
+	doc, ok := c.workspace.nodeTracker.astRoot.Documents[c.path]
+	if !ok {
+		return nil
+	}
+	for _, item := range doc.Items {
+		if item != nil && item.GetCommonMetaData().Key == group.MetaData.Key {
+			return item
+		}
+	}
+	return nil
+}
+
+func findSection(group *phase4.Group, name string) *phase4.Section {
+	for i := range group.Sections {
+		if group.Sections[i].Name == name {
+			return &group.Sections[i]
+		}
+	}
+	return nil
+}
+
+func containsSection(pattern []string, name string) bool {
+	for _, section := range pattern {
+		if section == name {
+			return true
+		}
+	}
+	return false
+}
+
+// getSectionPattern returns the first of the given patterns that starts with the first
+// section of the group and that contains all of the sections of the group.
+func getSectionPattern(group *phase4.Group, patterns [][]string) []string {
+	if len(group.Sections) == 0 {
+		return nil
+	}
+	for _, pattern := range patterns {
+		if pattern[0] != group.Sections[0].Name {
+			continue
+		}
+		found := true
+		for _, section := range group.Sections {
+			if phase5.GetSectionIndex(pattern, section.Name) < 0 {
+				found = false
+				break
+			}
+		}
+		if found {
+			return pattern
+		}
+	}
+	return nil
+}
+
+// getFirstSectionAfter returns the first section of the group that is after the given
+// section in the pattern.
+func getFirstSectionAfter(group *phase4.Group, pattern []string, name string) *phase4.Section {
+	index := phase5.GetSectionIndex(pattern, strings.TrimSuffix(name, "?"))
+	for i := range group.Sections {
+		if phase5.GetSectionIndex(pattern, group.Sections[i].Name) > index {
+			return &group.Sections[i]
+		}
+	}
+	return nil
+}
+
+// splitSignature returns the main names and the named group names of the signature, for
+// example `a.b` and [`c`] for `\:a.b:c`.
+func splitSignature(sig string) (string, []string, bool) {
+	if !strings.HasPrefix(sig, "\\:") || strings.Contains(sig, "::") ||
+		strings.HasSuffix(sig, ":/") {
+		return "", nil, false
+	}
+	parts := strings.Split(strings.TrimPrefix(sig, "\\:"), ":")
+	return parts[0], parts[1:], true
+}
+
+// getSignatureName returns the last of the main names of the signature, for example `b`
+// for `\:a.b:c`.
+func getSignatureName(sig string) string {
+	mainNames, _, _ := splitSignature(sig)
+	names := strings.Split(mainNames, ".")
+	return names[len(names)-1]
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type textLines struct {
+	text string
+	// the byte offset of the start of each line
+	lineStarts []int
+	positions  PositionIndex
+}
+
+func newTextLines(text string) textLines {
+	lineStarts := []int{0}
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\n' {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+	return textLines{
+		text:       text,
+		lineStarts: lineStarts,
+		positions:  NewPositionIndex(text),
+	}
+}
+
+func (t textLines) positionAt(offset int) ast.Position {
+	return t.positions.At(offset)
+}
+
+func (t textLines) rowAt(offset int) int {
+	return t.positionAt(offset).Row
+}
+
+func (t textLines) columnAt(offset int) int {
+	return t.positionAt(offset).Column
+}
+
+func (t textLines) lineStart(row int) int {
+	if row >= len(t.lineStarts) {
+		return len(t.text)
+	}
+	return t.lineStarts[row]
+}
+
+// lineEnd returns the offset of the newline ending the row or the end of the text.
+func (t textLines) lineEnd(row int) int {
+	if row+1 >= len(t.lineStarts) {
+		return len(t.text)
+	}
+	return t.lineStarts[row+1] - 1
+}
+
+func (t textLines) line(row int) string {
+	return t.text[t.lineStart(row):t.lineEnd(row)]
+}
+
+func (t textLines) isBlank(row int) bool {
+	return strings.TrimSpace(t.line(row)) == ""
+}
+
+// getBlockEndRow returns the last row before the first blank line after the given row.
+func (t textLines) getBlockEndRow(row int) int {
+	for row+1 < len(t.lineStarts) && !t.isBlank(row+1) {
+		row++
+	}
+	return row
+}
+
+// getGroupEndRow returns the last row of the section starting at the given row in a group
+// whose sections start at the given column.  That is, the last row of the lines after the
+// section that are indented more than the column, are arguments at the column, or are
+// comments.
+func (t textLines) getGroupEndRow(row int, column int) int {
+	for row+1 < len(t.lineStarts) && !t.isBlank(row+1) {
+		line := t.line(row + 1)
+		trimmed := strings.TrimLeft(line, " ")
+		indent := len(line) - len(trimmed)
+		if indent > column || (indent == column &&
+			(strings.HasPrefix(trimmed, ". ") || strings.HasPrefix(trimmed, "--"))) {
+			row++
+		} else {
+			break
+		}
+	}
+	return row
+}
+
+// getSectionStart returns the offset of the start of the line of the section at the given
+// offset including any comment lines directly before it, but not before the given offset.
+func (t textLines) getSectionStart(offset int, minOffset int) int {
+	row := t.rowAt(offset)
+	for row > 0 && t.lineStart(row-1) >= minOffset &&
+		strings.HasPrefix(strings.TrimSpace(t.line(row-1)), "--") {
+		row--
+	}
+	return t.lineStart(row)
+}
+
+func (t textLines) hasCommentBetween(startRow int, endRow int) bool {
+	for row := startRow; row <= endRow; row++ {
+		if strings.HasPrefix(t.line(row), "--") {
+			return true
+		}
+	}
+	return false
+}
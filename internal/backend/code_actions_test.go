@@ -0,0 +1,198 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func applyCodeActions(t *testing.T, content string, code frontend.DiagnosticCode) string {
+	workspace := newTestWorkspace(content)
+	edits := make([]TextEdit, 0)
+	for _, action := range workspace.CodeActions(ast.ToPath("test.math")) {
+		if action.Diagnostic.Code == code {
+			edits = append(edits, action.Edits...)
+		}
+	}
+	assert.NotEmpty(t, edits)
+	return ApplyEdits(content, edits)
+}
+
+func TestCodeActionMissingId(t *testing.T) {
+	newMetaId = func() string {
+		return "1"
+	}
+	actual := applyCodeActions(t, `Theorem:
+then: 'x'
+`, frontend.MissingIdCode)
+	assert.Equal(t, `Theorem:
+then: 'x'
+------------------------------------------
+Id: "1"
+`, actual)
+}
+
+func TestCodeActionMissingIdIsReportedByCheck(t *testing.T) {
+	workspace := newTestWorkspace(`Theorem:
+then: 'x'
+`)
+	actions := workspace.CodeActions(ast.ToPath("test.math"))
+	assert.Equal(t, 1, len(actions))
+	assert.Contains(t, workspace.Check().Diagnostics, actions[0].Diagnostic)
+}
+
+func TestCodeActionMissingDocumented(t *testing.T) {
+	actual := applyCodeActions(t, `[\set]
+Describes: X
+------------------------------------------
+Id: "1"
+`, frontend.MissingWrittenCode)
+	assert.Equal(t, `[\set]
+Describes: X
+Documented:
+. written: "\textrm{set}"
+------------------------------------------
+Id: "1"
+`, actual)
+}
+
+func TestFixFilesSkipsMissingDocumented(t *testing.T) {
+	content := `[\divides:by{d}]
+Defines: n
+------------------------------------------
+Id: "1"
+`
+	path := filepath.Join(t.TempDir(), "test.math")
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0644))
+
+	changed, diagnostics := FixFiles([]string{path}, false, nil)
+	assert.Empty(t, changed)
+	assert.Empty(t, diagnostics)
+	actual, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, content, string(actual))
+
+	changed, _ = FixFiles([]string{path}, true, nil)
+	assert.Equal(t, 1, len(changed))
+}
+
+func TestCodeActionGivenIfConflict(t *testing.T) {
+	content := `Theorem:
+given: x
+if: 'x'
+then: 'x'
+Id: "1"
+`
+	workspace := newTestWorkspace(content)
+	actions := workspace.CodeActions(ast.ToPath("test.math"))
+	assert.Equal(t, 1, len(actions))
+	assert.Equal(t, givenIfConflictMessage, actions[0].Diagnostic.Message)
+	assert.False(t, actions[0].IsSafe)
+	assert.Equal(t, `Theorem:
+given: x
+then: 'x'
+Id: "1"
+`, ApplyEdits(content, actions[0].Edits))
+}
+
+func TestCodeActionUnknownSignature(t *testing.T) {
+	actual := applyCodeActions(t, `[\function:on{A}:to{B}]
+Describes: f
+Id: "1"
+
+
+Theorem:
+given: f
+then: 'f is \functin:on{A}:to{B}'
+Id: "2"
+`, frontend.UnrecognizedSignatureCode)
+	assert.Equal(t, `[\function:on{A}:to{B}]
+Describes: f
+Id: "1"
+
+
+Theorem:
+given: f
+then: 'f is \function:on{A}:to{B}'
+Id: "2"
+`, actual)
+}
+
+func TestCodeActionUnknownSignatureFromCalledTextIsNotSafe(t *testing.T) {
+	workspace := newTestWorkspace(`[\prime.number]
+Defines: p
+Documented:
+. called: "prime number"
+Id: "1"
+
+
+Theorem:
+given: x
+then: 'x is \number.prime'
+Id: "2"
+`)
+	actions := workspace.CodeActions(ast.ToPath("test.math"))
+	assert.Equal(t, 1, len(actions))
+	assert.Equal(t, "Replace \\:number.prime with \\:prime.number", actions[0].Title)
+	assert.False(t, actions[0].IsSafe)
+}
+
+func TestCodeActionReorderSections(t *testing.T) {
+	actual := applyCodeActions(t, `Theorem:
+then:
+. forAll: x
+  then: 'x'
+  suchThat: 'x'
+-- the condition
+given: y
+------------------------------------------
+Id: "1"
+`, frontend.SectionOrderCode)
+	assert.Equal(t, `Theorem:
+-- the condition
+given: y
+then:
+. forAll: x
+  then: 'x'
+  suchThat: 'x'
+------------------------------------------
+Id: "1"
+`, actual)
+}
+
+func TestApplyEditsSkipsOverlappingEdits(t *testing.T) {
+	text := "abcdef"
+	actual := ApplyEdits(text, []TextEdit{
+		{
+			Start:   ast.Position{Offset: 1},
+			End:     ast.Position{Offset: 4},
+			NewText: "X",
+		},
+		{
+			Start:   ast.Position{Offset: 2},
+			End:     ast.Position{Offset: 5},
+			NewText: "Y",
+		},
+	})
+	assert.Equal(t, "aXef", actual)
+}
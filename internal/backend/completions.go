@@ -0,0 +1,505 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend/structural/phase4"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type CompletionKind string
+
+const (
+	SectionCompletion   CompletionKind = "section"
+	VariableCompletion  CompletionKind = "variable"
+	SignatureCompletion CompletionKind = "signature"
+)
+
+type Completion struct {
+	Kind  CompletionKind
+	Label string
+	// the text to insert, where `${n:text}` is the n-th placeholder
+	Snippet string
+}
+
+// Completions returns the completions that are valid at the given (zero based) position in
+// the document with the given path.  Inside a formulation, the variables in scope and the
+// signatures of the entries in the workspace are suggested.  Otherwise, the sections that
+// can be added to the group at the position are suggested.
+func (w *Workspace) Completions(path ast.Path, position ast.Position) []Completion {
+	content, _ := w.getContent(path)
+	lines := strings.Split(content, "\n")
+	if position.Row < 0 || position.Row >= len(lines) {
+		return []Completion{}
+	}
+	line := lines[position.Row]
+	lineBefore := line[:min(max(position.Column, 0), len(line))]
+
+	if strings.Count(lineBefore, "'")%2 == 1 {
+		result := w.getVariableCompletions(path, position)
+		return append(result, w.getSignatureCompletions()...)
+	}
+
+	trimmed := strings.TrimLeft(lineBefore, " ")
+	indent := len(lineBefore) - len(trimmed)
+	isArgument := strings.HasPrefix(trimmed, ". ")
+	if isArgument {
+		indent += 2
+		trimmed = trimmed[2:]
+	}
+	if !sectionPrefixRegex.MatchString(trimmed) {
+		return []Completion{}
+	}
+
+	phase4Doc, _ := w.nodeTracker.GetDocumentAt(path)
+	group := getTopLevelGroupAt(phase4Doc, lines, position.Row)
+	if group == nil {
+		if indent == 0 && !isArgument {
+			return toSectionCompletions(getFirstSections(topLevelSectionPatterns))
+		}
+		return []Completion{}
+	}
+
+	if isArgument {
+		// a new argument is being started in the section of the group whose sections
+		// start just before the dot
+		if getGroupAtColumn(group, lines, position.Row, indent-2) == nil {
+			return []Completion{}
+		}
+		return toSectionCompletions(getFirstSections(nestedSectionPatterns))
+	}
+
+	group = getGroupAtColumn(group, lines, position.Row, indent)
+	if group == nil {
+		return []Completion{}
+	}
+	present := make([]string, 0)
+	for _, section := range group.Sections {
+		if section.MetaData.Start.Row < position.Row {
+			present = append(present, section.Name)
+		}
+	}
+	return toSectionCompletions(getNextSections(present))
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+var sectionPrefixRegex = regexp.MustCompile(`^[a-zA-Z]*$`)
+
+var topLevelSectionPatterns = [][]string{
+	ast.DefinesSections,
+	ast.DescribesSections,
+	ast.StatesSections,
+	ast.CapturesSections,
+	ast.AxiomSections,
+	ast.ConjectureSections,
+	ast.TheoremSections,
+	ast.CorollarySections,
+	ast.LemmaSections,
+	ast.SpecifySections,
+	ast.PersonSections,
+	ast.ResourceSections,
+	ast.TopicSections,
+	ast.NoteSections,
+}
+
+var nestedSectionPatterns = [][]string{
+	ast.DeclareSections,
+	ast.AllOfSections,
+	ast.EquivalentlySections,
+	ast.NotSections,
+	ast.AnyOfSections,
+	ast.OneOfSections,
+	ast.ExistsSections,
+	ast.ExistsUniqueSections,
+	ast.ForAllSections,
+	ast.IfSections,
+	ast.IffSections,
+	ast.PiecewiseSections,
+	ast.InductivelySections,
+	ast.InductivelyCaseSections,
+	ast.MatchingSections,
+	ast.MatchingCaseSections,
+	ast.AssertingSections,
+	ast.SymbolWrittenSections,
+	ast.ViewSections,
+	ast.EncodingSections,
+	ast.WrittenSections,
+	ast.CalledSections,
+	ast.WritingSections,
+	ast.OverviewSections,
+	ast.RelatedSections,
+	ast.LabelSections,
+	ast.BySections,
+	ast.ZeroSections,
+	ast.PositiveIntSections,
+	ast.NegativeIntSections,
+	ast.PositiveFloatSections,
+	ast.NegativeFloatSections,
+	ast.NameSections,
+	ast.BiographySections,
+	ast.TitleSections,
+	ast.AuthorSections,
+	ast.OffsetSections,
+	ast.UrlSections,
+	ast.HomepageSections,
+	ast.TypeSections,
+	ast.EditorSections,
+	ast.EditionSections,
+	ast.InstitutionSections,
+	ast.JournalSections,
+	ast.PublisherSections,
+	ast.VolumeSections,
+	ast.MonthSections,
+	ast.YearSections,
+	ast.DescriptionSections,
+}
+
+func (w *Workspace) getContent(path ast.Path) (string, bool) {
+	for _, pair := range w.contents {
+		if pair.Path == path && pair.Content != nil {
+			return *pair.Content, true
+		}
+	}
+	return "", false
+}
+
+// getTopLevelGroupAt returns the top-level group that contains the given row, where a
+// group ends at the first blank line after it starts.
+func getTopLevelGroupAt(doc phase4.Document, lines []string, row int) *phase4.Group {
+	var result *phase4.Group
+	for _, node := range doc.Nodes {
+		if group, ok := node.(*phase4.Group); ok && group.MetaData.Start.Row <= row {
+			result = group
+		}
+	}
+	if result == nil {
+		return nil
+	}
+	for i := result.MetaData.Start.Row; i < row; i++ {
+		if strings.TrimSpace(lines[i]) == "" {
+			return nil
+		}
+	}
+	return result
+}
+
+// getGroupAtColumn returns the innermost group, starting at the given group, whose sections
+// start at the given (zero based) column and that contains the given row.
+func getGroupAtColumn(
+	group *phase4.Group,
+	lines []string,
+	row int,
+	column int,
+) *phase4.Group {
+	for group != nil {
+		if getGroupColumn(group, lines) == column {
+			return group
+		}
+		var next *phase4.Group
+		for _, section := range group.Sections {
+			if section.MetaData.Start.Row >= row {
+				break
+			}
+			for _, arg := range section.Args {
+				if argGroup, ok := arg.Arg.(*phase4.Group); ok &&
+					argGroup.MetaData.Start.Row < row {
+					next = argGroup
+				}
+			}
+		}
+		group = next
+	}
+	return nil
+}
+
+// getGroupColumn returns the zero based column of the first section of the group, which is
+// determined from the text since the columns of phase4 nodes are not consistently based.
+func getGroupColumn(group *phase4.Group, lines []string) int {
+	row := group.MetaData.Start.Row
+	if len(group.Sections) == 0 || row < 0 || row >= len(lines) {
+		return -1
+	}
+	return strings.Index(lines[row], group.Sections[0].Name+":")
+}
+
+func getFirstSections(patterns [][]string) []string {
+	result := make([]string, 0)
+	for _, pattern := range patterns {
+		result = appendUnique(result, pattern[0])
+	}
+	return result
+}
+
+// getNextSections returns the sections that can follow the given sections based on the
+// first pattern that matches the given sections.  That is, the optional sections after
+// the last given section and the first required section after them.
+func getNextSections(present []string) []string {
+	if len(present) == 0 {
+		return []string{}
+	}
+	patterns := append(append([][]string{}, topLevelSectionPatterns...), nestedSectionPatterns...)
+	for _, pattern := range patterns {
+		last, ok := matchSectionPattern(pattern, present)
+		if !ok {
+			continue
+		}
+		result := make([]string, 0)
+		for _, section := range pattern[last+1:] {
+			result = append(result, strings.TrimSuffix(section, "?"))
+			if !strings.HasSuffix(section, "?") {
+				break
+			}
+		}
+		return result
+	}
+	return []string{}
+}
+
+// matchSectionPattern returns the index in the pattern of the last of the given sections
+// if the given sections are in the order of the pattern and no required section is
+// missing between them.
+func matchSectionPattern(pattern []string, present []string) (int, bool) {
+	index := -1
+	for _, name := range present {
+		found := false
+		for index+1 < len(pattern) {
+			index++
+			section := pattern[index]
+			if strings.TrimSuffix(section, "?") == name {
+				found = true
+				break
+			}
+			if !strings.HasSuffix(section, "?") {
+				return -1, false
+			}
+		}
+		if !found {
+			return -1, false
+		}
+	}
+	return index, true
+}
+
+func toSectionCompletions(sections []string) []Completion {
+	result := make([]Completion, 0, len(sections))
+	for _, section := range sections {
+		name := strings.TrimSuffix(section, "?")
+		result = append(result, Completion{
+			Kind:    SectionCompletion,
+			Label:   name + ":",
+			Snippet: name + ": ",
+		})
+	}
+	return result
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// getVariableCompletions returns the names introduced in the targets and ids of the nodes
+// that contain the given position.
+func (w *Workspace) getVariableCompletions(path ast.Path, position ast.Position) []Completion {
+	doc, ok := w.nodeTracker.astRoot.Documents[path]
+	if !ok {
+		return []Completion{}
+	}
+	var cur ast.MlgNodeKind
+	for _, item := range doc.Items {
+		if item != nil && !isPositionAfter(item.GetCommonMetaData().Start, position) {
+			cur = item
+		}
+	}
+
+	names := make([]string, 0)
+	for cur != nil {
+		cur.ForEach(func(child ast.MlgNodeKind) {
+			switch n := child.(type) {
+			case *ast.Target:
+				forEachName(n.Root, func(name *ast.NameForm) {
+					if !isGeneratedName(name.Text) {
+						names = appendUnique(names, name.Text)
+					}
+				})
+			case *ast.IdItem:
+				for _, name := range getIdParamNames(n) {
+					names = appendUnique(names, name)
+				}
+			}
+		})
+		cur = getChildAt(cur, position)
+	}
+	sort.Strings(names)
+
+	result := make([]Completion, 0, len(names))
+	for _, name := range names {
+		result = append(result, Completion{
+			Kind:    VariableCompletion,
+			Label:   name,
+			Snippet: name,
+		})
+	}
+	return result
+}
+
+// isGeneratedName returns whether the name was generated when normalizing the workspace
+// rather than written in the document, for example `var'1'`.
+func isGeneratedName(name string) bool {
+	return strings.HasPrefix(name, "var'")
+}
+
+func getIdParamNames(id *ast.IdItem) []string {
+	cmd, ok := id.Root.(*ast.CommandId)
+	if !ok {
+		return []string{}
+	}
+	// the names of the command and of its named groups, such as on and to in
+	// \function:on{A}:to{B}, are not parameters
+	commandNames := make(map[*ast.NameForm]bool)
+	for i := range cmd.Names {
+		commandNames[&cmd.Names[i]] = true
+	}
+	if cmd.NamedParams != nil {
+		for i := range *cmd.NamedParams {
+			commandNames[&(*cmd.NamedParams)[i].Name] = true
+		}
+	}
+	result := make([]string, 0)
+	forEachName(cmd, func(name *ast.NameForm) {
+		if !commandNames[name] && !isGeneratedName(name.Text) {
+			result = appendUnique(result, name.Text)
+		}
+	})
+	return result
+}
+
+// getSignatureCompletions returns the signatures of the entries in the workspace with
+// snippets built from the input of each entry.
+func (w *Workspace) getSignatureCompletions() []Completion {
+	signatures := make([]string, 0, len(w.nodeTracker.signaturesToIds))
+	for sig := range w.nodeTracker.signaturesToIds {
+		signatures = append(signatures, sig)
+	}
+	sort.Strings(signatures)
+
+	result := make([]Completion, 0, len(signatures))
+	for _, sig := range signatures {
+		_, item, err := w.nodeTracker.GetEntryBySignature(sig)
+		if err != nil {
+			continue
+		}
+		snippet := "\\" + strings.TrimPrefix(sig, "\\:")
+		if summary, ok := GetInputSummary(item, w.diasnosticTracker); ok && summary != nil {
+			if text, ok := toSnippet(summary.Input); ok {
+				snippet = text
+			}
+		}
+		result = append(result, Completion{
+			Kind:    SignatureCompletion,
+			Label:   sig,
+			Snippet: snippet,
+		})
+	}
+	return result
+}
+
+// toSnippet converts the pattern for the input of an entry, for example the pattern for
+// `\function:on{A}:to{B}`, to a snippet such as `\function:on{${1:A}}:to{${2:B}}`.
+func toSnippet(pattern ast.PatternKind) (string, bool) {
+	s := snippetBuilder{}
+	switch p := pattern.(type) {
+	case *ast.CommandPattern:
+		s.command(p.Names, p.CurlyArg, p.NamedGroups, p.ParenArgs)
+		return s.text, true
+	case *ast.InfixCommandPattern:
+		s.command(p.Names, p.CurlyArg, p.NamedGroups, p.ParenArgs)
+		return s.text + "/", true
+	default:
+		return "", false
+	}
+}
+
+type snippetBuilder struct {
+	text  string
+	count int
+}
+
+func (s *snippetBuilder) command(
+	names []ast.NameFormPattern,
+	curly *ast.CurlyPattern,
+	namedGroups *[]ast.NamedGroupPattern,
+	parenArgs *[]ast.NameFormPattern,
+) {
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, name.Text)
+	}
+	s.text += "\\" + strings.Join(parts, ".")
+	if curly != nil {
+		s.curly(*curly)
+	}
+	if namedGroups != nil {
+		for _, group := range *namedGroups {
+			s.text += ":" + group.Name.Text
+			s.curly(group.Curly)
+		}
+	}
+	if parenArgs != nil {
+		params := make([]ast.FormPatternKind, 0, len(*parenArgs))
+		for i := range *parenArgs {
+			params = append(params, &(*parenArgs)[i])
+		}
+		s.params("(", params, ")")
+	}
+}
+
+func (s *snippetBuilder) curly(curly ast.CurlyPattern) {
+	if curly.SquareArgs != nil {
+		s.params("[", *curly.SquareArgs, "]")
+	}
+	if curly.CurlyArgs != nil {
+		s.params("{", *curly.CurlyArgs, "}")
+	}
+}
+
+func (s *snippetBuilder) params(prefix string, params []ast.FormPatternKind, suffix string) {
+	placeholders := make([]string, 0, len(params))
+	for _, param := range params {
+		s.count++
+		placeholders = append(placeholders,
+			fmt.Sprintf("${%d:%s}", s.count, getPlaceholderName(param)))
+	}
+	s.text += prefix + strings.Join(placeholders, ", ") + suffix
+}
+
+func getPlaceholderName(param ast.FormPatternKind) string {
+	switch p := param.(type) {
+	case *ast.NameFormPattern:
+		return p.Text
+	case *ast.SymbolFormPattern:
+		return p.Text
+	case *ast.FunctionFormPattern:
+		return p.Target.Text
+	case *ast.ExpressionFormPattern:
+		return p.Target.Text
+	default:
+		return "x"
+	}
+}
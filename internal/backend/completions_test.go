@@ -0,0 +1,118 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"mathlingua/internal/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const completionsInput = `
+[\function:on{A}:to{B}]
+Describes: f
+Id: "1"
+
+
+Theorem:
+given: x, y
+then:
+. 'x'
+`
+
+func getCompletionLabels(completions []Completion, kind CompletionKind) []string {
+	result := make([]string, 0)
+	for _, c := range completions {
+		if c.Kind == kind {
+			result = append(result, c.Label)
+		}
+	}
+	return result
+}
+
+func TestCompletionsAfterGiven(t *testing.T) {
+	content := "Theorem:\ngiven: x\n\n"
+	workspace := newTestWorkspace(content)
+	completions := workspace.Completions(ast.ToPath("test.math"), ast.Position{
+		Row:    2,
+		Column: 0,
+	})
+	assert.Equal(t, []string{
+		"declaring:",
+		"using:",
+		"where:",
+		"suchThat:",
+		"if:",
+		"iff:",
+		"then:",
+	}, getCompletionLabels(completions, SectionCompletion))
+}
+
+func TestCompletionsAtTopLevel(t *testing.T) {
+	workspace := newTestWorkspace(completionsInput)
+	completions := workspace.Completions(ast.ToPath("test.math"), ast.Position{
+		Row:    5,
+		Column: 0,
+	})
+	labels := getCompletionLabels(completions, SectionCompletion)
+	assert.Contains(t, labels, "Theorem:")
+	assert.Contains(t, labels, "Describes:")
+	assert.NotContains(t, labels, "then:")
+}
+
+func TestCompletionsInFormulation(t *testing.T) {
+	workspace := newTestWorkspace(completionsInput)
+	completions := workspace.Completions(ast.ToPath("test.math"), ast.Position{
+		Row:    9,
+		Column: 4,
+	})
+	assert.Equal(t, []string{"x", "y"},
+		getCompletionLabels(completions, VariableCompletion))
+	assert.Equal(t, []Completion{
+		{
+			Kind:    SignatureCompletion,
+			Label:   `\:function:on:to`,
+			Snippet: `\function:on{${1:A}}:to{${2:B}}`,
+		},
+	}, filterCompletions(completions, SignatureCompletion))
+}
+
+func TestCompletionsInNamedGroupDefinition(t *testing.T) {
+	workspace := newTestWorkspace(`
+[\function:on{A}:to{B}]
+Describes: f
+satisfies: 'f'
+Id: "1"
+`)
+	completions := workspace.Completions(ast.ToPath("test.math"), ast.Position{
+		Row:    3,
+		Column: 12,
+	})
+	assert.Equal(t, []string{"A", "B", "f"},
+		getCompletionLabels(completions, VariableCompletion))
+}
+
+func filterCompletions(completions []Completion, kind CompletionKind) []Completion {
+	result := make([]Completion, 0)
+	for _, c := range completions {
+		if c.Kind == kind {
+			result = append(result, c)
+		}
+	}
+	return result
+}
@@ -0,0 +1,189 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// definitionNode is an entry in the graph of definitions, where the entry depends on each
+// signature used in its `extends:`, `means:`, `expresses:`, and `equivalentTo:` sections.
+type definitionNode struct {
+	Id        string
+	Signature string
+	Path      ast.Path
+	Position  ast.Position
+	DependsOn []string
+}
+
+// findDefinitionCycles reports an error for each elementary cycle in the graph of
+// definitions, since a definition that depends on itself cannot be unfolded.  The cycles
+// are found with Johnson's algorithm, where each cycle starts at its smallest signature
+// so that it is only reported once.
+func (sm *SignatureManager) findDefinitionCycles() {
+	nodes := sm.getDefinitionNodes()
+	signatures := make([]string, 0, len(nodes))
+	for sig := range nodes {
+		signatures = append(signatures, sig)
+	}
+	sort.Strings(signatures)
+
+	for _, start := range signatures {
+		// only the cycles whose signatures are all at least the start are found, since
+		// the other cycles were found from an earlier start
+		isCandidate := func(sig string) bool {
+			_, ok := nodes[sig]
+			return ok && sig >= start
+		}
+		blocked := make(map[string]bool)
+		// the signatures to unblock when the key is unblocked
+		blockedBy := make(map[string][]string)
+		stack := make([]string, 0)
+
+		var unblock func(sig string)
+		unblock = func(sig string) {
+			blocked[sig] = false
+			waiting := blockedBy[sig]
+			delete(blockedBy, sig)
+			for _, other := range waiting {
+				if blocked[other] {
+					unblock(other)
+				}
+			}
+		}
+
+		var circuit func(sig string) bool
+		circuit = func(sig string) bool {
+			found := false
+			stack = append(stack, sig)
+			blocked[sig] = true
+			for _, dep := range nodes[sig].DependsOn {
+				if !isCandidate(dep) {
+					continue
+				}
+				if dep == start {
+					sm.reportDefinitionCycle(nodes, append([]string{}, stack...))
+					found = true
+				} else if !blocked[dep] && circuit(dep) {
+					found = true
+				}
+			}
+			if found {
+				unblock(sig)
+			} else {
+				for _, dep := range nodes[sig].DependsOn {
+					if isCandidate(dep) && !slices.Contains(blockedBy[dep], sig) {
+						blockedBy[dep] = append(blockedBy[dep], sig)
+					}
+				}
+			}
+			stack = stack[:len(stack)-1]
+			return found
+		}
+
+		circuit(start)
+	}
+}
+
+func (sm *SignatureManager) reportDefinitionCycle(
+	nodes map[string]definitionNode,
+	cycle []string,
+) {
+	chain := make([]string, 0, len(cycle)+1)
+	for _, sig := range append(cycle, cycle[0]) {
+		node := nodes[sig]
+		chain = append(chain, fmt.Sprintf("%s (id %s at %s (%d, %d))", node.Signature, node.Id,
+			node.Path, node.Position.Row+1, node.Position.Column+1))
+	}
+	first := nodes[cycle[0]]
+	sm.diasnosticTracker.Append(frontend.Diagnostic{
+		Type:     frontend.Error,
+		Origin:   frontend.BackendOrigin,
+		Code:     frontend.CircularDefinitionCode,
+		Message:  fmt.Sprintf("Circular definition:\n%s", strings.Join(chain, "\n-> ")),
+		Path:     first.Path,
+		Position: first.Position,
+	})
+}
+
+func (sm *SignatureManager) getDefinitionNodes() map[string]definitionNode {
+	nodes := make(map[string]definitionNode)
+	for path, doc := range sm.nodeTracker.astRoot.Documents {
+		for _, item := range doc.Items {
+			sig, ok := GetSignatureStringFromTopLevel(item)
+			if !ok {
+				continue
+			}
+			id, ok := GetAstMetaId(item)
+			if !ok {
+				continue
+			}
+			// only the entry recorded for a signature is used since the other entries
+			// are reported as duplicates
+			if sm.nodeTracker.signaturesToIds[sig] != id {
+				continue
+			}
+			dependsOn := make([]string, 0)
+			for _, clause := range getDefinitionClauses(item) {
+				for _, used := range getUsedSignatureStrings(clause) {
+					if !slices.Contains(dependsOn, used) {
+						dependsOn = append(dependsOn, used)
+					}
+				}
+			}
+			nodes[sig] = definitionNode{
+				Id:        id,
+				Signature: sig,
+				Path:      path,
+				Position:  item.GetCommonMetaData().Start,
+				DependsOn: dependsOn,
+			}
+		}
+	}
+	return nodes
+}
+
+// getDefinitionClauses returns the clauses in the `extends:`, `means:`, `expresses:`, and
+// `equivalentTo:` sections of the item.
+func getDefinitionClauses(item ast.TopLevelItemKind) []ast.ClauseKind {
+	result := make([]ast.ClauseKind, 0)
+	switch n := item.(type) {
+	case *ast.DescribesGroup:
+		if n.Extends != nil {
+			result = append(result, n.Extends.Extends...)
+		}
+		if n.EquivalentTo != nil {
+			result = append(result, n.EquivalentTo.EquivalentTo...)
+		}
+	case *ast.DefinesGroup:
+		if n.Means != nil {
+			result = append(result, n.Means.Means...)
+		}
+		if n.Expresses != nil {
+			result = append(result, n.Expresses.Expresses...)
+		}
+		if n.EquivalentTo != nil {
+			result = append(result, n.EquivalentTo.EquivalentTo...)
+		}
+	}
+	return result
+}
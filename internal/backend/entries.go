@@ -0,0 +1,126 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"errors"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/formulation"
+	"mathlingua/internal/frontend/structural/phase4"
+	"mathlingua/internal/mlglib"
+	"strings"
+)
+
+// EntryInfo summarizes a top-level entry with an Id: section.
+type EntryInfo struct {
+	Id string
+	// the name of the first section of the entry, for example Theorem
+	Kind string
+	// the signature of the entry or "" if it does not have one
+	Signature string
+	Path      ast.Path
+	// the position of the first section of the entry
+	Position ast.Position
+	Called   []string
+	Written  []string
+}
+
+// GetEntryInfos returns the entries in the workspace sorted by path and then position.
+func (w *Workspace) GetEntryInfos() []EntryInfo {
+	result := make([]EntryInfo, 0)
+	for _, path := range getSortedPaths(w.nodeTracker.astRoot.Documents) {
+		doc, ok := w.nodeTracker.astRoot.Documents[path]
+		if !ok {
+			continue
+		}
+		for _, item := range doc.Items {
+			if id, ok := GetAstMetaId(item); ok {
+				if info, ok := w.GetEntryInfoById(id); ok {
+					result = append(result, info)
+				}
+			}
+		}
+	}
+	return result
+}
+
+func (w *Workspace) GetEntryInfoById(id string) (EntryInfo, bool) {
+	phase4Entry, item, err := w.nodeTracker.GetEntryById(id)
+	if err != nil {
+		return EntryInfo{}, false
+	}
+	kind := ""
+	position := item.GetCommonMetaData().Start
+	if group, ok := phase4Entry.(*phase4.Group); ok && len(group.Sections) > 0 {
+		kind = group.Sections[0].Name
+		position = group.Sections[0].MetaData.Start
+	}
+	sig, _ := GetSignatureStringFromTopLevel(item)
+	called := make([]string, 0)
+	for _, summary := range GetCalledSummaries(getDocumentedSection(item)) {
+		called = append(called, summary.RawCalled)
+	}
+	written := make([]string, 0)
+	for _, summary := range GetWrittenSummaries(getDocumentedSection(item)) {
+		written = append(written, summary.RawWritten)
+	}
+	return EntryInfo{
+		Id:        id,
+		Kind:      kind,
+		Signature: sig,
+		Path:      w.getPathForItem(item),
+		Position:  position,
+		Called:    called,
+		Written:   written,
+	}, true
+}
+
+func (w *Workspace) GetEntryInfoBySignature(signature string) (EntryInfo, bool) {
+	id, ok := w.nodeTracker.GetIdForSignature(signature)
+	if !ok {
+		return EntryInfo{}, false
+	}
+	return w.GetEntryInfoById(id)
+}
+
+// GetContent returns the text of the document with the given path.
+func (w *Workspace) GetContent(path ast.Path) (string, bool) {
+	return w.getContent(path)
+}
+
+// RenderFormulation returns the written form, as LaTeX, of the given formulation text using
+// the written: forms of the entries in the workspace.
+func (w *Workspace) RenderFormulation(text string) (string, error) {
+	tracker := frontend.NewDiagnosticTracker()
+	node, ok := formulation.ParseExpression("", text, ast.Position{}, tracker,
+		mlglib.NewKeyGenerator(), w.nodeTracker.operators, w.nodeTracker.unicodeTable)
+	if !ok {
+		messages := make([]string, 0)
+		for _, diag := range tracker.Diagnostics() {
+			messages = append(messages, diag.Message)
+		}
+		if len(messages) == 0 {
+			messages = append(messages, "The formulation could not be parsed")
+		}
+		return "", errors.New(strings.Join(messages, "\n"))
+	}
+	// problems rendering the formulation are not problems in the workspace
+	resolver := w.writtenResolver
+	resolver.diagnosticTracker = tracker
+	return resolver.formulationNodeToWritten("", node), nil
+}
@@ -17,12 +17,16 @@
 package backend
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"mathlingua/internal/ast"
 	"mathlingua/internal/config"
 	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/formulation"
 	"mathlingua/internal/mlglib"
 	"os"
+	"path"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -35,23 +39,72 @@ import (
 func NewWorkspaceFromPaths(
 	paths []string,
 	tracker *frontend.DiagnosticTracker,
+	unicodeTable *formulation.UnicodeTable,
 ) (*Workspace, []frontend.Diagnostic) {
 	diagnostics := make([]frontend.Diagnostic, 0)
 
 	findFiles, findDiagnostics := getMathlinguaFiles(paths)
 	diagnostics = append(diagnostics, findDiagnostics...)
 
-	contents, contentDiagnostics := getFileContents(findFiles)
+	contents, contentDiagnostics := getFileContents(findFiles, appendMetaIds)
 	diagnostics = append(diagnostics, contentDiagnostics...)
 
-	return NewWorkspace(contents, tracker), diagnostics
+	return NewWorkspace(contents, tracker, unicodeTable), diagnostics
+}
+
+// NewWorkspaceFromFS creates a workspace from the Mathlingua files at the given paths in
+// the file system, or all of them if no paths are given.  Unlike NewWorkspaceFromPaths,
+// the files are only read and so entries without an Id: section are not given one.
+func NewWorkspaceFromFS(
+	fsys fs.FS,
+	paths []string,
+	tracker *frontend.DiagnosticTracker,
+	unicodeTable *formulation.UnicodeTable,
+) (*Workspace, []frontend.Diagnostic) {
+	diagnostics := make([]frontend.Diagnostic, 0)
+
+	findFiles, findDiagnostics := getMathlinguaFilesFS(fsys, paths)
+	diagnostics = append(diagnostics, findDiagnostics...)
+
+	contents, contentDiagnostics := getFileContents(findFiles, func(path string) (string, error) {
+		bytes, err := fs.ReadFile(fsys, path)
+		return string(bytes), err
+	})
+	diagnostics = append(diagnostics, contentDiagnostics...)
+
+	return NewWorkspace(contents, tracker, unicodeTable), diagnostics
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
 const toc_conf_name = "toc.conf"
 
+// osFS is a file system that passes paths, including absolute paths and paths containing
+// .., directly to the os package as opposed to os.DirFS that only accepts paths that are
+// valid for fs.FS.
+type osFS struct{}
+
+func (osFS) Open(name string) (fs.File, error) {
+	return os.Open(name)
+}
+
+func (osFS) Stat(name string) (fs.FileInfo, error) {
+	return os.Stat(name)
+}
+
+func (osFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	return os.ReadDir(name)
+}
+
+func (osFS) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(name)
+}
+
 func getMathlinguaFiles(paths []string) ([]PathLabelPair, []frontend.Diagnostic) {
+	return getMathlinguaFilesFS(osFS{}, paths)
+}
+
+func getMathlinguaFilesFS(fsys fs.FS, paths []string) ([]PathLabelPair, []frontend.Diagnostic) {
 	result := make([]PathLabelPair, 0)
 	diagnostics := make([]frontend.Diagnostic, 0)
 	mathPaths := make([]string, 0)
@@ -60,12 +113,13 @@ func getMathlinguaFiles(paths []string) ([]PathLabelPair, []frontend.Diagnostic)
 		mathPaths = append(mathPaths, ".")
 	} else {
 		for _, p := range paths {
-			stat, _ := os.Stat(p)
+			stat, _ := fs.Stat(fsys, p)
 			isDir := stat != nil && stat.IsDir()
 			if !isDir && !strings.HasSuffix(p, ".math") {
 				diagnostics = append(diagnostics, frontend.Diagnostic{
 					Type:    frontend.Warning,
 					Origin:  frontend.MlgCheckOrigin,
+					Code:    frontend.FileSystemErrorCode,
 					Path:    ast.Path(p),
 					Message: fmt.Sprintf("File %s is not a Mathlingua (.math) file and will be ignored", p),
 				})
@@ -76,27 +130,30 @@ func getMathlinguaFiles(paths []string) ([]PathLabelPair, []frontend.Diagnostic)
 	}
 
 	for _, p := range mathPaths {
-		getMathlinguaFilesImpl(p, &result, &diagnostics)
+		getMathlinguaFilesImpl(fsys, p, &result, &diagnostics)
 	}
 
 	return result, diagnostics
 }
 
 func getMathlinguaFilesImpl(
+	fsys fs.FS,
 	path string,
 	result *[]PathLabelPair,
 	diagnostics *[]frontend.Diagnostic,
 ) {
 	// ignore hidden files and directories like .git
-	if strings.HasPrefix(path, ".") && path != "." {
+	name := filepath.Base(path)
+	if strings.HasPrefix(name, ".") && name != "." && name != ".." {
 		return
 	}
 
-	stat, err := os.Stat(path)
+	stat, err := fs.Stat(fsys, path)
 	if err != nil {
 		*diagnostics = append(*diagnostics, frontend.Diagnostic{
 			Type:    frontend.Error,
 			Origin:  frontend.MlgCheckOrigin,
+			Code:    frontend.FileSystemErrorCode,
 			Path:    ast.Path(path),
 			Message: err.Error(),
 		})
@@ -108,13 +165,14 @@ func getMathlinguaFilesImpl(
 		// to the default config
 		tocConfig := config.NewDefaultTocConfig()
 
-		tocConfigPath := filepath.Join(path, toc_conf_name)
-		tocBytes, err := os.ReadFile(tocConfigPath)
+		tocConfigPath := joinPath(path, toc_conf_name)
+		tocBytes, err := fs.ReadFile(fsys, tocConfigPath)
 		if err != nil {
-			if !os.IsNotExist(err) {
+			if !errors.Is(err, fs.ErrNotExist) {
 				*diagnostics = append(*diagnostics, frontend.Diagnostic{
 					Type:    frontend.Error,
 					Origin:  frontend.MlgCheckOrigin,
+					Code:    frontend.FileSystemErrorCode,
 					Path:    ast.ToPath(tocConfigPath),
 					Message: err.Error(),
 				})
@@ -125,6 +183,7 @@ func getMathlinguaFilesImpl(
 				*diagnostics = append(*diagnostics, frontend.Diagnostic{
 					Type:    frontend.Error,
 					Origin:  frontend.MlgCheckOrigin,
+					Code:    frontend.FileSystemErrorCode,
 					Path:    ast.ToPath(tocConfigPath),
 					Message: err.Error(),
 				})
@@ -135,11 +194,12 @@ func getMathlinguaFilesImpl(
 
 		// at this point the tocConfig is either the loaded config
 		// or the default config
-		entries, err := os.ReadDir(path)
+		entries, err := fs.ReadDir(fsys, path)
 		if err != nil {
 			*diagnostics = append(*diagnostics, frontend.Diagnostic{
 				Type:    frontend.Error,
 				Origin:  frontend.MlgCheckOrigin,
+				Code:    frontend.FileSystemErrorCode,
 				Path:    ast.ToPath(tocConfigPath),
 				Message: err.Error(),
 			})
@@ -166,11 +226,12 @@ func getMathlinguaFilesImpl(
 					*diagnostics = append(*diagnostics, frontend.Diagnostic{
 						Type:    frontend.Error,
 						Origin:  frontend.MlgCheckOrigin,
+						Code:    frontend.FileSystemErrorCode,
 						Path:    ast.ToPath(tocConfigPath),
 						Message: fmt.Sprintf("The path %s does not exist", specPath),
 					})
 				} else if trueDirs.Has(specPath) {
-					dir := filepath.Join(path, specPath)
+					dir := joinPath(path, specPath)
 					label, shouldShow := tocConfig.LabelForFilename(specPath)
 					if shouldShow {
 						*result = append(*result, PathLabelPair{
@@ -179,14 +240,14 @@ func getMathlinguaFilesImpl(
 							IsDir: true,
 						})
 					}
-					getMathlinguaFilesImpl(dir, result, diagnostics)
+					getMathlinguaFilesImpl(fsys, dir, result, diagnostics)
 				} else {
 					// specPath is a file
 					usedPaths.Add(specPath)
 					label, shouldShow := tocConfig.LabelForFilename(specPath)
 					if shouldShow {
 						*result = append(*result, PathLabelPair{
-							Path:  ast.ToPath(filepath.Join(path, specPath)),
+							Path:  ast.ToPath(joinPath(path, specPath)),
 							Label: label,
 							IsDir: false,
 						})
@@ -214,10 +275,10 @@ func getMathlinguaFilesImpl(
 					}
 
 					if trueDirs.Has(p) {
-						getMathlinguaFilesImpl(filepath.Join(path, p), result, diagnostics)
+						getMathlinguaFilesImpl(fsys, joinPath(path, p), result, diagnostics)
 					} else {
 						*result = append(*result, PathLabelPair{
-							Path:  ast.ToPath(filepath.Join(path, p)),
+							Path:  ast.ToPath(joinPath(path, p)),
 							Label: pathNameToLabel(p),
 							IsDir: false,
 						})
@@ -239,6 +300,12 @@ func getMathlinguaFilesImpl(
 	})
 }
 
+// joinPath joins the given paths using forward slashes, as required by fs.FS, that are
+// also accepted by the os package on all platforms.
+func joinPath(dir string, name string) string {
+	return path.Join(filepath.ToSlash(dir), name)
+}
+
 func pathNameToLabel(name string) string {
 	result := ""
 	withoutSuffix := strings.TrimSuffix(name, ".math")
@@ -253,8 +320,12 @@ func pathNameToLabel(name string) string {
 	return result
 }
 
-func getFileContents(filePaths []PathLabelPair) (contents []PathLabelContent,
-	diagnostics []frontend.Diagnostic) {
+// getFileContents returns the contents of the given files where readFile reads the content
+// of a file at a path.
+func getFileContents(
+	filePaths []PathLabelPair,
+	readFile func(path string) (string, error),
+) (contents []PathLabelContent, diagnostics []frontend.Diagnostic) {
 	contents = make([]PathLabelContent, 0)
 	diagnostics = make([]frontend.Diagnostic, 0)
 
@@ -273,11 +344,12 @@ func getFileContents(filePaths []PathLabelPair) (contents []PathLabelContent,
 			continue
 		}
 
-		text, err := appendMetaIds(string(p.Path))
+		text, err := readFile(string(p.Path))
 		if err != nil {
 			diagnostics = append(diagnostics, frontend.Diagnostic{
 				Type:    frontend.Error,
 				Origin:  frontend.MlgCheckOrigin,
+				Code:    frontend.FileSystemErrorCode,
 				Path:    p.Path,
 				Message: err.Error(),
 			})
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMathlinguaFilesFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"content/toc.conf": {Data: []byte(`
+[toc]
+second.math = "The Second"
+hidden.math = "hide"
+* = "keep"
+`)},
+		"content/first_file.math":  {Data: []byte("")},
+		"content/second.math":      {Data: []byte("")},
+		"content/hidden.math":      {Data: []byte("")},
+		"content/notes.txt":        {Data: []byte("")},
+		"content/sub/third.math":   {Data: []byte("")},
+		"content/.git/config.math": {Data: []byte("")},
+	}
+
+	files, diagnostics := getMathlinguaFilesFS(fsys, []string{"content"})
+	assert.Empty(t, diagnostics)
+	assert.Equal(t, []PathLabelPair{
+		{Path: ast.ToPath("content/second.math"), Label: "The Second"},
+		{Path: ast.ToPath("content/first_file.math"), Label: "First File"},
+		{Path: ast.ToPath("content/sub/third.math"), Label: "Third"},
+	}, files)
+}
+
+func TestGetMathlinguaFilesFSMissingPath(t *testing.T) {
+	fsys := fstest.MapFS{
+		"toc.conf": {Data: []byte(`
+[toc]
+missing.math = "Missing"
+* = "keep"
+`)},
+	}
+
+	files, diagnostics := getMathlinguaFilesFS(fsys, nil)
+	assert.Empty(t, files)
+	assert.Equal(t, 1, len(diagnostics))
+	assert.Equal(t, frontend.FileSystemErrorCode, diagnostics[0].Code)
+	assert.Equal(t, ast.ToPath("toc.conf"), diagnostics[0].Path)
+	assert.Equal(t, "The path missing.math does not exist", diagnostics[0].Message)
+}
+
+func TestNewWorkspaceFromFS(t *testing.T) {
+	content := "Theorem:\nthen: 'x'\n"
+	fsys := fstest.MapFS{
+		"a.math": {Data: []byte(content)},
+	}
+
+	workspace, diagnostics := NewWorkspaceFromFS(fsys, nil, frontend.NewDiagnosticTracker(), nil)
+	assert.Empty(t, diagnostics)
+	assert.Equal(t, 1, workspace.DocumentCount())
+
+	// the file is read as is without an Id: being added
+	text, ok := workspace.GetContent(ast.ToPath("a.math"))
+	assert.True(t, ok)
+	assert.Equal(t, content, text)
+}
@@ -0,0 +1,288 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/formulation"
+	"mathlingua/internal/mlglib"
+	"strings"
+)
+
+// FindResult describes a formulation in the `then:` or `means:` section of an entry
+// that matches a query, together with what each variable in the query matched.
+type FindResult struct {
+	Id          string
+	Path        ast.Path
+	Position    ast.Position
+	Formulation string
+	Bindings    map[string]string
+}
+
+// Find returns the formulations in the `then:` and `means:` sections of the entries in the
+// workspace that match the given query.  A name followed by ? in the query, such as `x?`,
+// is a variable that matches any expression, where each use of a variable must match the
+// same expression.  Everything else in the query must match exactly.  For example, the
+// query `x? + y? = y? + x?` matches `a + 1 = 1 + a`.
+func (w *Workspace) Find(query string) ([]FindResult, error) {
+	queryNode, err := parseQuery(query, w.nodeTracker.unicodeTable)
+	if err != nil {
+		return nil, err
+	}
+	pattern := toQueryPattern(queryNode, mlglib.NewKeyGenerator())
+	variables := getQueryVariables(queryNode)
+
+	results := make([]FindResult, 0)
+	for _, path := range getSortedPaths(w.nodeTracker.astRoot.Documents) {
+		for _, item := range w.nodeTracker.astRoot.Documents[path].Items {
+			id, ok := GetAstMetaId(item)
+			if !ok {
+				continue
+			}
+			for _, clauses := range getFindableClauses(item) {
+				for _, clause := range clauses {
+					forEachExpression(clause, func(node ast.ExpressionKind) {
+						bindings, ok := matchQuery(node, queryNode, pattern, variables)
+						if !ok {
+							return
+						}
+						results = append(results, FindResult{
+							Id:          id,
+							Path:        path,
+							Position:    node.GetCommonMetaData().Start,
+							Formulation: node.ToCode(ast.NoOp),
+							Bindings:    bindings,
+						})
+					})
+				}
+			}
+		}
+	}
+	return results, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// parseQuery parses the query as a form, if possible, so that it has the same meaning as
+// the forms used to describe inputs, and otherwise parses it as an expression, since forms
+// cannot describe expressions such as `x? + y? = y? + x?`.
+func parseQuery(
+	query string,
+	unicodeTable *formulation.UnicodeTable,
+) (ast.FormulationNodeKind, error) {
+	tracker := frontend.NewDiagnosticTracker()
+	form, ok := formulation.ParseForm(
+		"", query, ast.Position{}, tracker, mlglib.NewKeyGenerator(), nil, unicodeTable)
+	if ok && len(tracker.Diagnostics()) == 0 {
+		return form, nil
+	}
+
+	tracker = frontend.NewDiagnosticTracker()
+	exp, ok := formulation.ParseExpression(
+		"", query, ast.Position{}, tracker, mlglib.NewKeyGenerator(), nil, unicodeTable)
+	if ok && len(tracker.Diagnostics()) == 0 {
+		return exp, nil
+	}
+
+	messages := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, diag := range tracker.Diagnostics() {
+		if !seen[diag.Message] {
+			seen[diag.Message] = true
+			messages = append(messages, diag.Message)
+		}
+	}
+	if len(messages) == 0 {
+		messages = append(messages, "The query could not be parsed")
+	}
+	return nil, errors.New(strings.Join(messages, "\n"))
+}
+
+// toQueryPattern returns the pattern used to find the bindings of the variables in the
+// query.  Parts of the query that cannot be described by a pattern match anything, and
+// are instead checked by matchQuery.
+func toQueryPattern(node ast.FormulationNodeKind, keyGen *mlglib.KeyGenerator) ast.PatternKind {
+	switch n := node.(type) {
+	case ast.StructuralFormKind:
+		return ToFormPattern(n)
+	case *ast.InfixOperatorCallExpression:
+		return &ast.InfixOperatorFormPattern{
+			Operator: toQueryOperatorPattern(n, keyGen),
+			Lhs:      toQueryFormPattern(n.Lhs, keyGen),
+			Rhs:      toQueryFormPattern(n.Rhs, keyGen),
+		}
+	case *ast.PrefixOperatorCallExpression:
+		return &ast.PrefixOperatorFormPattern{
+			Operator: toQueryOperatorPattern(n, keyGen),
+			Param:    toQueryFormPattern(n.Arg, keyGen),
+		}
+	case *ast.PostfixOperatorCallExpression:
+		return &ast.PostfixOperatorFormPattern{
+			Operator: toQueryOperatorPattern(n, keyGen),
+			Param:    toQueryFormPattern(n.Arg, keyGen),
+		}
+	case *ast.FunctionCallExpression:
+		if target, ok := n.Target.(*ast.NameForm); ok {
+			params := make([]ast.FormPatternKind, 0, len(n.Args))
+			for _, arg := range n.Args {
+				params = append(params, toQueryFormPattern(arg, keyGen))
+			}
+			return &ast.FunctionFormPattern{
+				Target: *ToNameFormPattern(*target),
+				Params: params,
+			}
+		}
+	case *ast.TupleExpression:
+		params := make([]ast.FormPatternKind, 0, len(n.Args))
+		for _, arg := range n.Args {
+			params = append(params, toQueryFormPattern(arg, keyGen))
+		}
+		return &ast.TupleFormPattern{
+			Params: params,
+		}
+	}
+	return newQueryWildcard(keyGen)
+}
+
+func toQueryFormPattern(node ast.FormulationNodeKind, keyGen *mlglib.KeyGenerator) ast.FormPatternKind {
+	if pattern, ok := toQueryPattern(node, keyGen).(ast.FormPatternKind); ok {
+		return pattern
+	}
+	return newQueryWildcard(keyGen)
+}
+
+func toQueryOperatorPattern(
+	node ast.FormulationNodeKind,
+	keyGen *mlglib.KeyGenerator,
+) ast.NameFormPattern {
+	if text, ok := formulation.GetOperatorText(node); ok {
+		return toNameFormPatternFromText(text)
+	}
+	return *newQueryWildcard(keyGen)
+}
+
+// newQueryWildcard returns a pattern that matches anything using a name that
+// cannot be used in a query.
+func newQueryWildcard(keyGen *mlglib.KeyGenerator) *ast.NameFormPattern {
+	pattern := toNameFormPatternFromText(fmt.Sprintf("query'%d'", keyGen.Next()))
+	return &pattern
+}
+
+// getQueryVariables returns the names followed by ? in the query.
+func getQueryVariables(node ast.MlgNodeKind) []ast.NameForm {
+	result := make([]ast.NameForm, 0)
+	var visit func(node ast.MlgNodeKind)
+	visit = func(node ast.MlgNodeKind) {
+		if node == nil {
+			return
+		}
+		if name, ok := node.(*ast.NameForm); ok && name.HasQuestionMark {
+			result = append(result, *name)
+		}
+		node.ForEach(visit)
+	}
+	visit(node)
+	return result
+}
+
+// matchQuery determines if the node matches the query and, if so, returns the code of what
+// each variable in the query matched.  The pattern match determines the bindings of the
+// variables, and the node matches if substituting the bindings into the query results in
+// the node.  This ensures the parts of the query that are not variables match exactly and
+// that each use of a variable matches the same expression.
+func matchQuery(
+	node ast.ExpressionKind,
+	query ast.FormulationNodeKind,
+	pattern ast.PatternKind,
+	variables []ast.NameForm,
+) (map[string]string, bool) {
+	match := Match(node, pattern)
+	if !match.MatchMakesSense || len(match.Messages) > 0 {
+		return nil, false
+	}
+
+	bindings := make(map[string]string)
+	for _, variable := range variables {
+		if variable.VarArg.IsVarArg {
+			values, ok := match.VarArgMapping[variable.Text]
+			if !ok {
+				return nil, false
+			}
+			codes := make([]string, 0, len(values))
+			for _, value := range values {
+				codes = append(codes, ast.Debug(value, ast.NoOp))
+			}
+			bindings[variable.Text] = strings.Join(codes, ", ")
+		} else {
+			value, ok := match.Mapping[variable.Text]
+			if !ok {
+				return nil, false
+			}
+			bindings[variable.Text] = ast.Debug(value, ast.NoOp)
+		}
+	}
+
+	substituted := query.ToCode(func(node ast.MlgNodeKind) (string, bool) {
+		if name, ok := node.(*ast.NameForm); ok && name.HasQuestionMark {
+			binding, ok := bindings[name.Text]
+			return binding, ok
+		}
+		return "", false
+	})
+	if substituted != node.ToCode(ast.NoOp) {
+		return nil, false
+	}
+	return bindings, true
+}
+
+// getFindableClauses returns the clauses of the `then:` and `means:` sections of the item.
+func getFindableClauses(item ast.TopLevelItemKind) [][]ast.ClauseKind {
+	switch n := item.(type) {
+	case *ast.TheoremGroup:
+		return [][]ast.ClauseKind{n.Then.Clauses}
+	case *ast.AxiomGroup:
+		return [][]ast.ClauseKind{n.Then.Clauses}
+	case *ast.ConjectureGroup:
+		return [][]ast.ClauseKind{n.Then.Clauses}
+	case *ast.LemmaGroup:
+		return [][]ast.ClauseKind{n.Then.Clauses}
+	case *ast.CorollaryGroup:
+		return [][]ast.ClauseKind{n.Then.Clauses}
+	case *ast.DefinesGroup:
+		if n.Means != nil {
+			return [][]ast.ClauseKind{n.Means.Means}
+		}
+	}
+	return nil
+}
+
+// forEachExpression calls fn with each expression in the given node, including
+// the expressions nested in other expressions.
+func forEachExpression(node ast.MlgNodeKind, fn func(node ast.ExpressionKind)) {
+	if node == nil {
+		return
+	}
+	if exp, ok := node.(ast.ExpressionKind); ok {
+		fn(exp)
+	}
+	node.ForEach(func(subNode ast.MlgNodeKind) {
+		forEachExpression(subNode, fn)
+	})
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const findInput = `
+Theorem:
+given: a, b
+then:
+. 'a + b = b + a'
+. 'a + b = a + b'
+------------------------------------------
+Id: "1"
+
+
+[\idempotent]
+Defines: X
+means: 'X * X = X'
+Documented:
+. called: "idempotent"
+------------------------------------------
+Id: "2"
+
+
+Theorem:
+given: f, x, y
+then: 'f(x + y) = f(y + x)'
+------------------------------------------
+Id: "3"
+`
+
+func TestFindCommutativity(t *testing.T) {
+	workspace := newTestWorkspace(findInput)
+
+	results, err := workspace.Find("x? + y? = y? + x?")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, "1", results[0].Id)
+	assert.Equal(t, "a + b = b + a", results[0].Formulation)
+	assert.Equal(t, map[string]string{
+		"x": "a",
+		"y": "b",
+	}, results[0].Bindings)
+}
+
+func TestFindNestedExpression(t *testing.T) {
+	workspace := newTestWorkspace(findInput)
+
+	results, err := workspace.Find("x? + y?")
+	assert.Nil(t, err)
+	formulations := make([]string, 0)
+	for _, result := range results {
+		formulations = append(formulations, result.Id+": "+result.Formulation)
+	}
+	assert.Equal(t, []string{
+		"1: a + b",
+		"1: b + a",
+		"1: a + b",
+		"1: a + b",
+		"3: x + y",
+		"3: y + x",
+	}, formulations)
+}
+
+func TestFindRepeatedVariableInMeans(t *testing.T) {
+	workspace := newTestWorkspace(findInput)
+
+	results, err := workspace.Find("x? * x? = x?")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, "2", results[0].Id)
+	assert.Equal(t, map[string]string{
+		"x": "X",
+	}, results[0].Bindings)
+}
+
+func TestFindInLemmaAndCorollary(t *testing.T) {
+	workspace := newTestWorkspace(`
+Lemma:
+for: "\\:some.theorem"
+given: a, b
+then: 'a * b = b * a'
+------------------------------------------
+Id: "1"
+
+
+Corollary:
+to: "\\:some.theorem"
+given: x
+then: 'x * 1 = 1 * x'
+------------------------------------------
+Id: "2"
+`)
+
+	results, err := workspace.Find("x? * y? = y? * x?")
+	assert.Nil(t, err)
+	ids := make([]string, 0)
+	for _, result := range results {
+		ids = append(ids, result.Id)
+	}
+	assert.Equal(t, []string{"1", "2"}, ids)
+}
+
+func TestFindInvalidQuery(t *testing.T) {
+	workspace := newTestWorkspace(findInput)
+
+	_, err := workspace.Find("x? + (")
+	assert.NotNil(t, err)
+}
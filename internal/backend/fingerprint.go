@@ -0,0 +1,291 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"sort"
+	"strings"
+)
+
+// GetFingerprint returns a hash of the normal form of the statement of a `Theorem:`,
+// `Lemma:`, `Corollary:`, or `Axiom:` entry.  Two entries have the same fingerprint
+// exactly when their statements are the same up to the renaming of bound variables
+// and the order of clauses.
+func GetFingerprint(item ast.TopLevelItemKind) (string, bool) {
+	normalForm, ok := GetStatementNormalForm(item)
+	if !ok {
+		return "", false
+	}
+	hash := sha256.Sum256([]byte(normalForm))
+	return hex.EncodeToString(hash[:]), true
+}
+
+// GetStatementNormalForm returns the normal form of the statement of a `Theorem:`,
+// `Lemma:`, `Corollary:`, or `Axiom:` entry.  In the normal form, each bound variable is
+// renamed based on the order in which it is bound and the clauses of each section are
+// sorted, since the clauses of a section, such as `allOf:` or `anyOf:`, can be given in
+// any order.
+func GetStatementNormalForm(item ast.TopLevelItemKind) (string, bool) {
+	n := newStatementNormalizer()
+	switch item := item.(type) {
+	case *ast.TheoremGroup:
+		return n.statement(item.Given, item.Declaring, item.Using, item.Where, item.SuchThat,
+			item.If, item.Iff, item.Then), true
+	case *ast.LemmaGroup:
+		return n.statement(item.Given, item.Declaring, item.Using, item.Where, item.SuchThat,
+			item.If, item.Iff, item.Then), true
+	case *ast.CorollaryGroup:
+		return n.statement(item.Given, item.Declaring, item.Using, item.Where, item.SuchThat,
+			item.If, item.Iff, item.Then), true
+	case *ast.AxiomGroup:
+		return n.statement(item.Given, item.Declaring, item.Using, item.Where, item.SuchThat,
+			item.If, item.Iff, item.Then), true
+	default:
+		return "", false
+	}
+}
+
+// CheckDuplicateStatements reports a warning for each `Theorem:`, `Lemma:`, `Corollary:`,
+// or `Axiom:` entry whose statement is the same, up to renaming, as the statement of an
+// entry that occurs before it.
+func CheckDuplicateStatements(root *ast.Root, tracker *frontend.DiagnosticTracker) {
+	fingerprintsToIds := make(map[string]string)
+	for _, path := range getSortedPaths(root.Documents) {
+		for _, item := range root.Documents[path].Items {
+			fingerprint, ok := GetFingerprint(item)
+			if !ok {
+				continue
+			}
+			id, ok := GetAstMetaId(item)
+			if !ok {
+				continue
+			}
+			if otherId, ok := fingerprintsToIds[fingerprint]; ok {
+				tracker.Append(frontend.Diagnostic{
+					Type:   frontend.Warning,
+					Origin: frontend.BackendOrigin,
+					Code:   frontend.DuplicateStatementCode,
+					Message: fmt.Sprintf(
+						"The entry with id %s is equivalent to the entry with id %s up to renaming",
+						id, otherId),
+					Path:     path,
+					Position: item.GetCommonMetaData().Start,
+				})
+			} else {
+				fingerprintsToIds[fingerprint] = id
+			}
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// statementNormalizer renames bound variables by the depth at which they are bound so that
+// the names used in one clause do not depend on the variables bound in its sibling clauses.
+// This allows the clauses of a section to be sorted after they are normalized.
+type statementNormalizer struct {
+	scopes []map[string]string
+	count  int
+}
+
+func newStatementNormalizer() *statementNormalizer {
+	return &statementNormalizer{
+		scopes: []map[string]string{make(map[string]string)},
+	}
+}
+
+func (n *statementNormalizer) statement(
+	given *ast.GivenSection,
+	declaring *ast.DeclaringSection,
+	using *ast.UsingSection,
+	where *ast.WhereSection,
+	suchThat *ast.SuchThatSection,
+	ifSection *ast.IfSection,
+	iff *ast.IffSection,
+	then ast.ThenSection,
+) string {
+	lines := make([]string, 0)
+	if given != nil {
+		lines = append(lines, "given:"+n.bind(given.Given))
+	}
+	if declaring != nil {
+		lines = append(lines, "declaring:"+n.bind(declaring.Declaring))
+	}
+	if using != nil {
+		lines = append(lines, "using:"+n.bind(using.Using))
+	}
+	if where != nil {
+		lines = append(lines, "where:"+n.specs(where.Specs))
+	}
+	if suchThat != nil {
+		lines = append(lines, "suchThat:"+n.clauses(suchThat.Clauses))
+	}
+	if ifSection != nil {
+		lines = append(lines, "if:"+n.clauses(ifSection.Clauses))
+	}
+	if iff != nil {
+		lines = append(lines, "iff:"+n.clauses(iff.Clauses))
+	}
+	lines = append(lines, "then:"+n.clauses(then.Clauses))
+	return strings.Join(lines, "\n")
+}
+
+// bind records the names in the targets as bound in the current scope, in the order they
+// occur, and returns the normalized targets.
+func (n *statementNormalizer) bind(targets []ast.Target) string {
+	scope := n.scopes[len(n.scopes)-1]
+	for _, target := range targets {
+		forEachName(target.Root, func(name *ast.NameForm) {
+			if _, ok := scope[name.Text]; !ok {
+				n.count++
+				scope[name.Text] = fmt.Sprintf("bound'%d'", n.count)
+			}
+		})
+	}
+	result := make([]string, 0, len(targets))
+	for _, target := range targets {
+		result = append(result, n.formulation(target.Root))
+	}
+	return "(" + strings.Join(result, ",") + ")"
+}
+
+func (n *statementNormalizer) specs(specs []ast.Spec) string {
+	result := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		result = append(result, n.formulation(spec.Root))
+	}
+	sort.Strings(result)
+	return "(" + strings.Join(result, ",") + ")"
+}
+
+func (n *statementNormalizer) clauses(clauses []ast.ClauseKind) string {
+	result := make([]string, 0, len(clauses))
+	for _, clause := range clauses {
+		result = append(result, n.clause(clause))
+	}
+	sort.Strings(result)
+	return "(" + strings.Join(result, ",") + ")"
+}
+
+func (n *statementNormalizer) clause(clause ast.ClauseKind) string {
+	switch c := clause.(type) {
+	case *ast.Formulation[ast.FormulationNodeKind]:
+		return "'" + n.formulation(c.Root) + "'"
+	case *ast.TextItem:
+		return "\"" + c.RawText + "\""
+	case *ast.AllOfGroup:
+		return "allOf:" + n.clauses(c.AllOf.Clauses)
+	case *ast.AnyOfGroup:
+		return "anyOf:" + n.clauses(c.AnyOf.Clauses)
+	case *ast.OneOfGroup:
+		return "oneOf:" + n.clauses(c.OneOf.Clauses)
+	case *ast.NotGroup:
+		return "not:" + n.clause(c.Not.Clause)
+	case *ast.EquivalentlyGroup:
+		return "equivalently:" + n.clauses(c.Equivalently.Clauses)
+	case *ast.IfGroup:
+		return "if:" + n.clauses(c.If.Clauses) + "then:" + n.clauses(c.Then.Clauses)
+	case *ast.IffGroup:
+		return "iff:" + n.clauses(c.Iff.Clauses) + "then:" + n.clauses(c.Then.Clauses)
+	case *ast.ForAllGroup:
+		return n.scoped(func() string {
+			result := "forAll:" + n.bind(c.ForAll.Targets)
+			result += n.quantifierSections(c.Using, c.Where, c.SuchThat)
+			return result + "then:" + n.clauses(c.Then.Clauses)
+		})
+	case *ast.ExistsGroup:
+		return n.scoped(func() string {
+			result := "exists:" + n.bind(c.Exists.Targets)
+			return result + n.quantifierSections(c.Using, c.Where, c.SuchThat)
+		})
+	case *ast.ExistsUniqueGroup:
+		return n.scoped(func() string {
+			result := "existsUnique:" + n.bind(c.ExistsUnique.Targets)
+			return result + n.quantifierSections(c.Using, c.Where, &c.SuchThat)
+		})
+	default:
+		// the other clauses are rarely used in statements and so their bound variables
+		// are not renamed
+		return ast.Debug(clause, ast.NoOp)
+	}
+}
+
+func (n *statementNormalizer) quantifierSections(
+	using *ast.UsingSection,
+	where *ast.WhereSection,
+	suchThat *ast.SuchThatSection,
+) string {
+	result := ""
+	if using != nil {
+		result += "using:" + n.bind(using.Using)
+	}
+	if where != nil {
+		result += "where:" + n.specs(where.Specs)
+	}
+	if suchThat != nil {
+		result += "suchThat:" + n.clauses(suchThat.Clauses)
+	}
+	return result
+}
+
+// scoped calls fn in a new scope and then discards the variables bound in fn so that the
+// next variable bound has the same name as the first variable bound in fn.
+func (n *statementNormalizer) scoped(fn func() string) string {
+	count := n.count
+	n.scopes = append(n.scopes, make(map[string]string))
+	result := fn()
+	n.scopes = n.scopes[:len(n.scopes)-1]
+	n.count = count
+	return result
+}
+
+func (n *statementNormalizer) formulation(node ast.FormulationNodeKind) string {
+	if node == nil {
+		return ""
+	}
+	var rename func(node ast.MlgNodeKind) (string, bool)
+	rename = func(node ast.MlgNodeKind) (string, bool) {
+		name, ok := node.(*ast.NameForm)
+		if !ok {
+			return "", false
+		}
+		for i := len(n.scopes) - 1; i >= 0; i-- {
+			if renamed, ok := n.scopes[i][name.Text]; ok {
+				return renamed + name.VarArg.ToCode(rename), true
+			}
+		}
+		return "", false
+	}
+	return node.ToCode(rename)
+}
+
+func forEachName(node ast.MlgNodeKind, fn func(name *ast.NameForm)) {
+	if node == nil {
+		return
+	}
+	if name, ok := node.(*ast.NameForm); ok {
+		fn(name)
+	}
+	node.ForEach(func(subNode ast.MlgNodeKind) {
+		forEachName(subNode, fn)
+	})
+}
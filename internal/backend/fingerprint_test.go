@@ -0,0 +1,106 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"mathlingua/internal/frontend"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const fingerprintInput = `
+Theorem:
+given: a, b
+then: 'a + b = b + a'
+------------------------------------------
+Id: "1"
+
+
+Axiom:
+given: x, y
+then: 'x + y = y + x'
+------------------------------------------
+Id: "2"
+
+
+Theorem:
+given: x, y
+then: 'y + x = x + y'
+------------------------------------------
+Id: "3"
+
+
+Theorem:
+given: f
+then:
+. allOf:
+  . forAll: x
+    then: 'f(x) = x'
+  . exists: y
+    suchThat: 'f(y) = f(f(y))'
+------------------------------------------
+Id: "4"
+
+
+Theorem:
+given: g
+then:
+. allOf:
+  . exists: b
+    suchThat: 'g(b) = g(g(b))'
+  . forAll: a
+    then: 'g(a) = a'
+------------------------------------------
+Id: "5"
+`
+
+func TestFingerprintRenamesBoundVariables(t *testing.T) {
+	workspace := newTestWorkspace(fingerprintInput)
+
+	assert.Equal(t, getFingerprint(t, workspace, "1"), getFingerprint(t, workspace, "2"))
+	assert.NotEqual(t, getFingerprint(t, workspace, "1"), getFingerprint(t, workspace, "3"))
+}
+
+func TestFingerprintSortsClauses(t *testing.T) {
+	workspace := newTestWorkspace(fingerprintInput)
+
+	assert.Equal(t, getFingerprint(t, workspace, "4"), getFingerprint(t, workspace, "5"))
+	assert.NotEqual(t, getFingerprint(t, workspace, "1"), getFingerprint(t, workspace, "4"))
+}
+
+func TestCheckDuplicateStatements(t *testing.T) {
+	workspace := newTestWorkspace(fingerprintInput)
+
+	messages := make([]string, 0)
+	for _, diag := range workspace.Check().Diagnostics {
+		if diag.Code == frontend.DuplicateStatementCode {
+			assert.Equal(t, frontend.Warning, diag.Type)
+			messages = append(messages, diag.Message)
+		}
+	}
+	assert.Equal(t, []string{
+		"The entry with id 2 is equivalent to the entry with id 1 up to renaming",
+		"The entry with id 5 is equivalent to the entry with id 4 up to renaming",
+	}, messages)
+}
+
+func getFingerprint(t *testing.T, workspace *Workspace, id string) string {
+	fingerprint, ok := workspace.GetFingerprintById(id)
+	assert.True(t, ok)
+	return fingerprint
+}
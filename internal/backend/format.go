@@ -0,0 +1,166 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/formulation"
+	"mathlingua/internal/frontend/structural/phase1"
+	"mathlingua/internal/frontend/structural/phase4"
+	"os"
+	"strings"
+)
+
+// SymbolStyle describes how Unicode symbols in formulations are written by FormatText.
+type SymbolStyle string
+
+const (
+	KeepSymbols    SymbolStyle = ""
+	AsciiSymbols   SymbolStyle = "ascii"
+	UnicodeSymbols SymbolStyle = "unicode"
+)
+
+type FormatOptions struct {
+	Symbols SymbolStyle
+	// the normalizations of Unicode symbols or nil to use the default normalizations
+	UnicodeTable *formulation.UnicodeTable
+	// whether the sections of each group are reordered to the order the group expects
+	ReorderSections bool
+}
+
+// FormatText returns the given Mathlingua text with its formulations rewritten and its
+// sections reordered as described by the options.  All other text, including comments and
+// whitespace, is left as is.
+func FormatText(text string, options FormatOptions) string {
+	if options.ReorderSections {
+		text = reorderSections(text)
+	}
+	if options.Symbols == KeepSymbols {
+		return text
+	}
+
+	// the text is only rewritten and so syntax errors are reported by `mlg check`
+	lexer := phase1.NewLexer(text, "", frontend.NewDiagnosticTracker())
+	result := strings.Builder{}
+	offset := 0
+	for lexer.HasNext() {
+		token := lexer.Next()
+		var start int
+		switch token.Type {
+		case ast.FormulationTokenType:
+			// the formulation text starts after the opening '
+			start = token.Position.Offset + 1
+		case ast.ArgumentText:
+			// arguments that are not enclosed, such as the targets in `given: α, β`, are
+			// also formulations
+			start = token.Position.Offset
+		default:
+			continue
+		}
+		end := start + len(token.Text)
+		if start < offset || end > len(text) || text[start:end] != token.Text {
+			continue
+		}
+		result.WriteString(text[offset:start])
+		result.WriteString(formatFormulationText(token.Text, options))
+		offset = end
+	}
+	result.WriteString(text[offset:])
+	return result.String()
+}
+
+// FormatFiles formats the Mathlingua files at the given paths in place and returns the
+// paths of the files that were changed.
+func FormatFiles(paths []string, options FormatOptions) ([]ast.Path, []frontend.Diagnostic) {
+	files, diagnostics := getMathlinguaFiles(paths)
+	changed := make([]ast.Path, 0)
+	for _, file := range files {
+		if file.IsDir {
+			continue
+		}
+		content, err := os.ReadFile(string(file.Path))
+		if err != nil {
+			diagnostics = append(diagnostics, frontend.Diagnostic{
+				Type:    frontend.Error,
+				Origin:  frontend.CliOrigin,
+				Code:    frontend.FileSystemErrorCode,
+				Path:    file.Path,
+				Message: err.Error(),
+			})
+			continue
+		}
+		formatted := FormatText(string(content), options)
+		if formatted == string(content) {
+			continue
+		}
+		if err := os.WriteFile(string(file.Path), []byte(formatted), 0644); err != nil {
+			diagnostics = append(diagnostics, frontend.Diagnostic{
+				Type:    frontend.Error,
+				Origin:  frontend.CliOrigin,
+				Code:    frontend.FileSystemErrorCode,
+				Path:    file.Path,
+				Message: err.Error(),
+			})
+			continue
+		}
+		changed = append(changed, file.Path)
+	}
+	return changed, diagnostics
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// reorderSections returns the text with the sections of each group, including nested
+// groups, moved to the order the group expects.  The comments before a section move with it.
+func reorderSections(text string) string {
+	// an edit for a group overlaps the edits for the groups nested in it and so the text
+	// is reordered until nothing changes
+	for pass := 0; pass < maxFixPasses; pass++ {
+		doc := parsePhase4Document(text, "", frontend.NewDiagnosticTracker())
+		c := codeActionBuilder{
+			text:   newTextLines(text),
+			result: make([]CodeAction, 0),
+		}
+		for _, node := range doc.Nodes {
+			if group, ok := node.(*phase4.Group); ok {
+				c.forEachGroup(group, c.addReorderSectionsAction)
+			}
+		}
+		edits := make([]TextEdit, 0)
+		for _, action := range c.result {
+			edits = append(edits, action.Edits...)
+		}
+		reordered := ApplyEdits(text, edits)
+		if reordered == text {
+			break
+		}
+		text = reordered
+	}
+	return text
+}
+
+func formatFormulationText(text string, options FormatOptions) string {
+	switch options.Symbols {
+	case AsciiSymbols:
+		return formulation.ToAsciiText(text, options.UnicodeTable)
+	case UnicodeSymbols:
+		return formulation.ToUnicodeText(text, options.UnicodeTable)
+	default:
+		return text
+	}
+}
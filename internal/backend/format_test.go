@@ -0,0 +1,93 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatTextSymbols(t *testing.T) {
+	unicode := `-- α ≤ β is left as is in comments
+Theorem:
+given: α, β
+where: 'α ∈ β'
+then: 'α ≤ β'
+Documented:
+. overview: "α ≤ β"
+------------------------------------------
+Id: "1"
+`
+	ascii := `-- α ≤ β is left as is in comments
+Theorem:
+given: alpha, beta
+where: 'alpha \.in./ beta'
+then: 'alpha <= beta'
+Documented:
+. overview: "α ≤ β"
+------------------------------------------
+Id: "1"
+`
+	assert.Equal(t, ascii, FormatText(unicode, FormatOptions{Symbols: AsciiSymbols}))
+	assert.Equal(t, unicode, FormatText(ascii, FormatOptions{Symbols: UnicodeSymbols}))
+	assert.Equal(t, unicode, FormatText(unicode, FormatOptions{}))
+}
+
+func TestFormatTextUnicodeKeepsAsciiOperators(t *testing.T) {
+	ascii := `Theorem:
+given: f(x) := y
+then: 'x - y * z ... <= w'
+------------------------------------------
+Id: "1"
+`
+	assert.Equal(t, `Theorem:
+given: f(x) := y
+then: 'x - y * z ... ≤ w'
+------------------------------------------
+Id: "1"
+`, FormatText(ascii, FormatOptions{Symbols: UnicodeSymbols}))
+}
+
+func TestFormatTextReorderSections(t *testing.T) {
+	input := `Theorem:
+then:
+. forAll: x
+  then: 'x' -- the result
+  suchThat: 'x'
+-- the condition
+given: y
+Documented:
+. overview: "something"
+------------------------------------------
+Id: "1"
+`
+	expected := `Theorem:
+-- the condition
+given: y
+then:
+. forAll: x
+  suchThat: 'x'
+  then: 'x' -- the result
+Documented:
+. overview: "something"
+------------------------------------------
+Id: "1"
+`
+	assert.Equal(t, expected, FormatText(input, FormatOptions{ReorderSections: true}))
+	assert.Equal(t, expected, FormatText(expected, FormatOptions{ReorderSections: true}))
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"mathlingua/internal/ast"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Hierarchy describes where a `Describes:` entry is in the hierarchy formed by the
+// `extends:` and `satisfies:` sections of the `Describes:` entries.
+type Hierarchy struct {
+	Signature string
+	Id        string
+	// the signatures used in the entry's `extends:` and `satisfies:` sections
+	Parents []string
+	// the parents of the entry, the parents of its parents, etc.
+	Ancestors []string
+	// the signatures of the `Describes:` entries that have the entry as a parent
+	Children []string
+	// the signatures of the entries whose `specifies:` section uses the entry
+	SpecifiedBy []string
+}
+
+// GetHierarchy returns the hierarchy of the `Describes:` entry with the given signature.
+// The signature can be given as either `\:a.b` or `\a.b`.
+func (w *Workspace) GetHierarchy(signature string) (Hierarchy, error) {
+	signature = normalizeSignature(signature)
+	graph := w.getHierarchyGraph()
+	if _, ok := graph.parents[signature]; !ok {
+		return Hierarchy{}, fmt.Errorf("Could not find a Describes: entry with signature %s",
+			signature)
+	}
+	return w.toHierarchy(graph, signature), nil
+}
+
+// GetHierarchies returns the hierarchy of each `Describes:` entry in the workspace keyed
+// by its signature.
+func (w *Workspace) GetHierarchies() map[string]Hierarchy {
+	graph := w.getHierarchyGraph()
+	result := make(map[string]Hierarchy, len(graph.parents))
+	for signature := range graph.parents {
+		result[signature] = w.toHierarchy(graph, signature)
+	}
+	return result
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// normalizeSignature converts a signature such as `\a.b` to the form `\:a.b` used to
+// identify entries.
+func normalizeSignature(signature string) string {
+	if strings.HasPrefix(signature, "\\") && !strings.HasPrefix(signature, "\\:") {
+		return "\\:" + signature[1:]
+	}
+	return signature
+}
+
+// hierarchyGraph records the edges of the hierarchy of the `Describes:` entries.
+type hierarchyGraph struct {
+	// map the signature of each `Describes:` entry to the signatures of its parents
+	parents map[string][]string
+	// map signatures to the signatures of the entries whose `specifies:` section uses them
+	specifiedBy map[string][]string
+}
+
+func (w *Workspace) getHierarchyGraph() hierarchyGraph {
+	graph := hierarchyGraph{
+		parents:     make(map[string][]string),
+		specifiedBy: make(map[string][]string),
+	}
+	for _, item := range w.getSortedItems() {
+		sig, ok := GetSignatureStringFromTopLevel(item)
+		if !ok {
+			continue
+		}
+		if describes, ok := item.(*ast.DescribesGroup); ok {
+			graph.parents[sig] = w.getKnownSignatures(getHierarchyClauses(describes))
+		}
+		for _, used := range w.getKnownSignatures(getSpecifiesNodes(item)) {
+			graph.specifiedBy[used] = appendUnique(graph.specifiedBy[used], sig)
+		}
+	}
+	return graph
+}
+
+func (w *Workspace) toHierarchy(graph hierarchyGraph, signature string) Hierarchy {
+	ancestors := make([]string, 0)
+	queue := append([]string{}, graph.parents[signature]...)
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		if next == signature || slices.Contains(ancestors, next) {
+			continue
+		}
+		ancestors = append(ancestors, next)
+		queue = append(queue, graph.parents[next]...)
+	}
+
+	children := make([]string, 0)
+	for sig, sigParents := range graph.parents {
+		if slices.Contains(sigParents, signature) {
+			children = append(children, sig)
+		}
+	}
+	sort.Strings(children)
+
+	specified, ok := graph.specifiedBy[signature]
+	if !ok {
+		specified = make([]string, 0)
+	}
+
+	id, _ := w.nodeTracker.GetIdForSignature(signature)
+	return Hierarchy{
+		Signature:   signature,
+		Id:          id,
+		Parents:     graph.parents[signature],
+		Ancestors:   ancestors,
+		Children:    children,
+		SpecifiedBy: specified,
+	}
+}
+
+func (w *Workspace) getSortedItems() []ast.TopLevelItemKind {
+	result := make([]ast.TopLevelItemKind, 0)
+	for _, path := range getSortedPaths(w.nodeTracker.astRoot.Documents) {
+		result = append(result, w.nodeTracker.astRoot.Documents[path].Items...)
+	}
+	return result
+}
+
+// getKnownSignatures returns the signatures of entries in the workspace used in the nodes
+// in the order they are first used.
+func (w *Workspace) getKnownSignatures(nodes []ast.MlgNodeKind) []string {
+	result := make([]string, 0)
+	for _, node := range nodes {
+		for _, sig := range getUsedSignatureStrings(node) {
+			if _, ok := w.nodeTracker.signaturesToIds[sig]; ok {
+				result = appendUnique(result, sig)
+			}
+		}
+	}
+	return result
+}
+
+func getHierarchyClauses(describes *ast.DescribesGroup) []ast.MlgNodeKind {
+	result := make([]ast.MlgNodeKind, 0)
+	if describes.Extends != nil {
+		for _, clause := range describes.Extends.Extends {
+			result = append(result, clause)
+		}
+	}
+	if describes.Satisfies != nil {
+		for _, clause := range describes.Satisfies.Satisfies {
+			result = append(result, clause)
+		}
+	}
+	return result
+}
+
+func getSpecifiesNodes(item ast.TopLevelItemKind) []ast.MlgNodeKind {
+	var specifies *ast.SpecifiesSection
+	switch n := item.(type) {
+	case *ast.DescribesGroup:
+		specifies = n.Specifies
+	case *ast.DefinesGroup:
+		specifies = n.Specifies
+	case *ast.StatesGroup:
+		specifies = n.Specifies
+	}
+	result := make([]ast.MlgNodeKind, 0)
+	if specifies != nil {
+		for i := range specifies.Specifies {
+			result = append(result, &specifies.Specifies[i])
+		}
+	}
+	return result
+}
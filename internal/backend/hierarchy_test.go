@@ -0,0 +1,103 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const hierarchyInput = `
+[\set]
+Describes: X
+------------------------------------------
+Id: "1"
+
+
+[\group]
+Describes: G
+extends: 'G is \set'
+------------------------------------------
+Id: "2"
+
+
+[\abelian.group]
+Describes: G
+extends: 'G is \group'
+------------------------------------------
+Id: "3"
+
+
+[\ring]
+Describes: R
+extends: 'R is \abelian.group'
+satisfies: 'R is \set'
+------------------------------------------
+Id: "4"
+
+
+[\ideal]
+Describes: I
+specifies: 'R is \ring'
+------------------------------------------
+Id: "5"
+`
+
+func TestGetHierarchy(t *testing.T) {
+	workspace := newTestWorkspace(hierarchyInput)
+
+	hierarchy, err := workspace.GetHierarchy("\\ring")
+	assert.Nil(t, err)
+	assert.Equal(t, Hierarchy{
+		Signature:   "\\:ring",
+		Id:          "4",
+		Parents:     []string{"\\:abelian.group", "\\:set"},
+		Ancestors:   []string{"\\:abelian.group", "\\:set", "\\:group"},
+		Children:    []string{},
+		SpecifiedBy: []string{"\\:ideal"},
+	}, hierarchy)
+}
+
+func TestGetHierarchyChildren(t *testing.T) {
+	workspace := newTestWorkspace(hierarchyInput)
+
+	hierarchy, err := workspace.GetHierarchy("\\:set")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, hierarchy.Parents)
+	assert.Equal(t, []string{}, hierarchy.Ancestors)
+	assert.Equal(t, []string{"\\:group", "\\:ring"}, hierarchy.Children)
+}
+
+func TestGetHierarchyUnknownSignature(t *testing.T) {
+	workspace := newTestWorkspace(hierarchyInput)
+
+	_, err := workspace.GetHierarchy("\\field")
+	assert.NotNil(t, err)
+}
+
+func TestGetHierarchies(t *testing.T) {
+	workspace := newTestWorkspace(hierarchyInput)
+
+	hierarchies := workspace.GetHierarchies()
+	for _, signature := range []string{"\\:set", "\\:group", "\\:ring"} {
+		hierarchy, err := workspace.GetHierarchy(signature)
+		assert.Nil(t, err)
+		assert.Equal(t, hierarchy, hierarchies[signature])
+	}
+	assert.Equal(t, 5, len(hierarchies))
+}
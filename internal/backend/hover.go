@@ -0,0 +1,180 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"mathlingua/internal/ast"
+)
+
+type Definition struct {
+	Signature string
+	Id        string
+	// the path and (zero based) position of the entry that defines the signature
+	Path     ast.Path
+	Position ast.Position
+}
+
+type Hover struct {
+	Signature string
+	Id        string
+	// the command at the position rendered using the `written:` form of its entry
+	Written string
+	// the text of the `overview:` item in the `Documented:` section of the entry
+	Overview string
+}
+
+// Definition returns where the entry that defines the command at the given (zero based)
+// position is located.
+func (w *Workspace) Definition(path ast.Path, position ast.Position) (Definition, error) {
+	sig, _, err := w.getSignatureAt(path, position)
+	if err != nil {
+		return Definition{}, err
+	}
+	id, item, err := w.getEntryForSignature(sig)
+	if err != nil {
+		return Definition{}, err
+	}
+	return Definition{
+		Signature: sig,
+		Id:        id,
+		Path:      w.getPathForItem(item),
+		Position:  item.GetCommonMetaData().Start,
+	}, nil
+}
+
+// Hover returns a description of the command at the given (zero based) position.
+func (w *Workspace) Hover(path ast.Path, position ast.Position) (Hover, error) {
+	sig, node, err := w.getSignatureAt(path, position)
+	if err != nil {
+		return Hover{}, err
+	}
+	id, item, err := w.getEntryForSignature(sig)
+	if err != nil {
+		return Hover{}, err
+	}
+
+	documented := getDocumentedSection(item)
+	written := ""
+	switch node.(type) {
+	case *ast.CommandExpression, *ast.InfixCommandExpression:
+		written = w.writtenResolver.formulationNodeToWritten(path, node)
+	default:
+		if summaries := GetWrittenSummaries(documented); len(summaries) > 0 {
+			written = summaries[0].RawWritten
+		}
+	}
+
+	overview := ""
+	if documented != nil {
+		for _, docItem := range documented.Documented {
+			if item, ok := docItem.(*ast.OverviewGroup); ok {
+				overview = item.Overview.Overview.RawText
+				break
+			}
+		}
+	}
+
+	return Hover{
+		Signature: sig,
+		Id:        id,
+		Written:   written,
+		Overview:  overview,
+	}, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// getSignatureAt returns the signature of the innermost command, command id, or signature
+// that contains the given position, together with the node for it.
+func (w *Workspace) getSignatureAt(
+	path ast.Path,
+	position ast.Position,
+) (string, ast.MlgNodeKind, error) {
+	nodeAt, ok := w.NodeAt(path, position)
+	if !ok {
+		return "", nil, fmt.Errorf("There is not a formulation at %s (%d, %d)",
+			path, position.Row+1, position.Column+1)
+	}
+	chain := append(append([]ast.MlgNodeKind{}, nodeAt.Enclosing...), nodeAt.Node)
+	for i := len(chain) - 1; i >= 0; i-- {
+		switch n := chain[i].(type) {
+		case *ast.CommandExpression:
+			return GetSignatureStringFromCommand(*n), n, nil
+		case *ast.InfixCommandExpression:
+			return GetSignatureStringFromInfixCommand(*n), n, nil
+		case *ast.CommandId:
+			return GetSignatureStringFromCommandId(*n), n, nil
+		case *ast.InfixCommandId:
+			return GetSignatureStringFromInfixCommandId(*n), n, nil
+		case *ast.Signature:
+			return n.ToCode(ast.NoOp), n, nil
+		}
+	}
+	return "", nil, fmt.Errorf("There is not a command at %s (%d, %d)",
+		path, position.Row+1, position.Column+1)
+}
+
+func (w *Workspace) getEntryForSignature(sig string) (string, ast.TopLevelItemKind, error) {
+	id, ok := w.nodeTracker.GetIdForSignature(sig)
+	if !ok {
+		return "", nil, fmt.Errorf("Unrecognized signature %s", sig)
+	}
+	_, item, err := w.nodeTracker.GetEntryById(id)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, item, nil
+}
+
+func (w *Workspace) getPathForItem(item ast.TopLevelItemKind) ast.Path {
+	for path, doc := range w.nodeTracker.astRoot.Documents {
+		for _, docItem := range doc.Items {
+			if docItem == item {
+				return path
+			}
+		}
+	}
+	return ast.ToPath("")
+}
+
+func getDocumentedSection(item ast.TopLevelItemKind) *ast.DocumentedSection {
+	switch n := item.(type) {
+	case *ast.DescribesGroup:
+		return n.Documented
+	case *ast.DefinesGroup:
+		return n.Documented
+	case *ast.StatesGroup:
+		return n.Documented
+	case *ast.CapturesGroup:
+		return n.Documented
+	case *ast.AxiomGroup:
+		return n.Documented
+	case *ast.ConjectureGroup:
+		return n.Documented
+	case *ast.TheoremGroup:
+		return n.Documented
+	case *ast.LemmaGroup:
+		return n.Documented
+	case *ast.CorollaryGroup:
+		return n.Documented
+	case *ast.TopicGroup:
+		return n.Documented
+	default:
+		return nil
+	}
+}
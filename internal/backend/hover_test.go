@@ -0,0 +1,90 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend/structural/phase4"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const hoverInput = `
+[\group]
+Describes: G
+Documented:
+. overview: "A set with an associative operation."
+. called: "group"
+------------------------------------------
+Id: "1"
+
+
+Theorem:
+given: G
+then: 'G is \group'
+------------------------------------------
+Id: "2"
+`
+
+func TestNodeAt(t *testing.T) {
+	workspace := newTestWorkspace(hoverInput)
+
+	// the position of the 'g' in `\group` in the Theorem:
+	result, ok := workspace.NodeAt(ast.ToPath("test.math"), ast.Position{Row: 12, Column: 13})
+	assert.True(t, ok)
+	assert.Equal(t, "group", ast.FormulationNodeToCode(result.Node, ast.NoOp))
+
+	_, isTheorem := result.Enclosing[0].(*ast.TheoremGroup)
+	assert.True(t, isTheorem)
+	_, isCommand := result.Enclosing[len(result.Enclosing)-1].(*ast.CommandExpression)
+	assert.True(t, isCommand)
+
+	_, isGroup := result.Phase4Enclosing[0].(*phase4.Group)
+	assert.True(t, isGroup)
+
+	_, ok = workspace.NodeAt(ast.ToPath("test.math"), ast.Position{Row: 12, Column: 2})
+	assert.False(t, ok)
+}
+
+func TestDefinition(t *testing.T) {
+	workspace := newTestWorkspace(hoverInput)
+
+	definition, err := workspace.Definition(ast.ToPath("test.math"),
+		ast.Position{Row: 12, Column: 13})
+	assert.Nil(t, err)
+	assert.Equal(t, "\\:group", definition.Signature)
+	assert.Equal(t, "1", definition.Id)
+	assert.Equal(t, ast.ToPath("test.math"), definition.Path)
+	assert.Equal(t, 2, definition.Position.Row)
+
+	_, err = workspace.Definition(ast.ToPath("test.math"), ast.Position{Row: 12, Column: 7})
+	assert.NotNil(t, err)
+}
+
+func TestHover(t *testing.T) {
+	workspace := newTestWorkspace(hoverInput)
+
+	hover, err := workspace.Hover(ast.ToPath("test.math"), ast.Position{Row: 12, Column: 13})
+	assert.Nil(t, err)
+	assert.Equal(t, Hover{
+		Signature: "\\:group",
+		Id:        "1",
+		Written:   "\\textrm{group}",
+		Overview:  "A set with an associative operation.",
+	}, hover)
+}
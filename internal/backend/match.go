@@ -67,6 +67,8 @@ func Match(node ast.MlgNodeKind, pattern ast.PatternKind) MatchResult {
 		return matchPostfixOperator(node, *p)
 	case *ast.StructuralColonEqualsPattern:
 		return matchStructuralColonEquals(node, *p)
+	case *ast.StructuralColonEqualsColonPattern:
+		return matchStructuralColonEqualsColon(node, *p)
 	case *ast.InfixCommandOperatorPattern:
 		return matchInfixOperatorCommand(node, *p)
 	case *ast.ChainExpressionPattern:
@@ -126,7 +128,55 @@ func unionMatches(result1 MatchResult, result2 MatchResult) MatchResult {
 	}
 }
 
-// TODO: add matchStructuralColonEqualsColon
+// matchStructuralColonEqualsColon matches a node against `lhs :=: rhs` where the node
+// can be written in the form of either side.  For example, with `f(x...) :=: f((x...))`
+// both `f(a, b)` and `f((a, b))` match.
+func matchStructuralColonEqualsColon(node ast.MlgNodeKind,
+	pattern ast.StructuralColonEqualsColonPattern) MatchResult {
+	if n, ok := node.(*ast.StructuralColonEqualsColonForm); ok {
+		lhsMatch := Match(n.Lhs, pattern.Lhs)
+		rhsMatch := Match(n.Rhs, pattern.Rhs)
+		return unionMatches(lhsMatch, rhsMatch)
+	}
+
+	// prefer the side that has the same shape as the node since, for example,
+	// any expression matches a function form by mapping the function's name
+	sides := []ast.FormPatternKind{pattern.Lhs, pattern.Rhs}
+	if !hasSameShape(node, pattern.Lhs) && hasSameShape(node, pattern.Rhs) {
+		sides = []ast.FormPatternKind{pattern.Rhs, pattern.Lhs}
+	}
+
+	var firstMatch *MatchResult
+	for _, side := range sides {
+		match := Match(node, side)
+		if match.MatchMakesSense && len(match.Messages) == 0 {
+			return match
+		}
+		if firstMatch == nil {
+			firstMatch = &match
+		}
+	}
+	return *firstMatch
+}
+
+func hasSameShape(node ast.MlgNodeKind, pattern ast.FormPatternKind) bool {
+	switch pattern.(type) {
+	case *ast.FunctionFormPattern:
+		switch node.(type) {
+		case *ast.FunctionForm, *ast.FunctionCallExpression:
+			return true
+		}
+	case *ast.ExpressionFormPattern:
+		_, ok := node.(*ast.ExpressionForm)
+		return ok
+	case *ast.TupleFormPattern:
+		switch node.(type) {
+		case *ast.TupleForm, *ast.TupleExpression:
+			return true
+		}
+	}
+	return false
+}
 
 func matchStructuralColonEquals(node ast.MlgNodeKind,
 	pattern ast.StructuralColonEqualsPattern) MatchResult {
@@ -139,6 +189,8 @@ func matchStructuralColonEquals(node ast.MlgNodeKind,
 		return Match(n, pattern.Lhs)
 	case *ast.ExpressionForm:
 		return Match(n, pattern.Lhs)
+	case *ast.StructuralColonEqualsColonForm:
+		return Match(n, pattern.Lhs)
 	case *ast.StructuralColonEqualsForm:
 		name, ok := n.Lhs.(*ast.NameForm)
 		if !ok {
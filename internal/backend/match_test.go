@@ -97,6 +97,60 @@ func TestTuple(t *testing.T) {
 	}, map[string][]string{})
 }
 
+func TestStructuralColonEqualsColon(t *testing.T) {
+	pattern := ToFormPattern(parseForm(t, "(X, op, e) :=: ((X, op), e)"))
+
+	match := Match(parseNode(t, "(G, p, z)"), pattern)
+	assertMatchSucceeded(t, match)
+	assert.Equal(t, "X -> G\ne -> z\nop -> p\n", sortedMappingToString(match.Mapping))
+
+	match = Match(parseNode(t, "((G, p), z)"), pattern)
+	assertMatchSucceeded(t, match)
+	assert.Equal(t, "X -> G\ne -> z\nop -> p\n", sortedMappingToString(match.Mapping))
+
+	match = Match(parseNode(t, "(G, p)"), pattern)
+	assert.NotEqual(t, 0, len(match.Messages))
+}
+
+func TestStructuralColonEqualsColonVarArg(t *testing.T) {
+	pattern := ToFormPattern(parseForm(t, "f(x...) :=: f((x...))"))
+
+	match := Match(parseNode(t, "g(a, b)"), pattern)
+	assertMatchSucceeded(t, match)
+	assert.Equal(t, "f -> g\n", sortedMappingToString(match.Mapping))
+}
+
+func TestStructuralColonEqualsColonWithMissingIdentifier(t *testing.T) {
+	form := parseForm(t, "(X, op, e) :=: ((X, op), e)")
+	target := replaceMissingIdentifier(ast.Target{Root: form}, mlglib.NewKeyGenerator())
+	pattern := ToPatternFromTarget(target)
+	assert.NotNil(t, pattern)
+
+	match := Match(parseForm(t, "M := ((G, p), z)"), pattern)
+	assertMatchSucceeded(t, match)
+	assert.Equal(t, "X -> G\ne -> z\nop -> p\nvar'1' -> M\n",
+		sortedMappingToString(match.Mapping))
+}
+
+func assertMatchSucceeded(t *testing.T, match MatchResult) {
+	assert.True(t, match.MatchMakesSense)
+	assert.Equal(t, []string{}, append([]string{}, match.Messages...))
+}
+
+func sortedMappingToString(mapping map[string]ast.MlgNodeKind) string {
+	keys := make([]string, 0, len(mapping))
+	for key := range mapping {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := ""
+	for _, key := range keys {
+		result += key + " -> " + ast.Debug(mapping[key], ast.NoOp) + "\n"
+	}
+	return result
+}
+
 func runTest(t *testing.T, expText string, patternText string,
 	expectedSingle map[string]string,
 	expectedVarArg map[string][]string) {
@@ -185,7 +239,7 @@ func parseNode(t *testing.T, exp string) ast.MlgNodeKind {
 			Offset: 0,
 			Row:    0,
 			Column: 0,
-		}, tracker, keyGen)
+		}, tracker, keyGen, nil, nil)
 		return root, tracker, ok
 	})
 }
@@ -198,7 +252,7 @@ func parseForm(t *testing.T, exp string) ast.StructuralFormKind {
 			Offset: 0,
 			Row:    0,
 			Column: 0,
-		}, tracker, keyGen)
+		}, tracker, keyGen, nil, nil)
 		return root, tracker, ok
 	})
 	switch n := node.(type) {
@@ -0,0 +1,186 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend/structural/phase4"
+	"strings"
+)
+
+type NodeAtResult struct {
+	// the innermost formulation node at the position
+	Node ast.FormulationNodeKind
+	// the phase5 nodes that contain the node starting with the top-level item
+	Enclosing []ast.MlgNodeKind
+	// the phase4 nodes that correspond to the structural nodes in Enclosing
+	Phase4Enclosing []phase4.Node
+}
+
+// NodeAt returns the innermost formulation node at the given (zero based) position in the
+// document with the given path.  Since nodes only record where they start, a node is
+// considered to contain the position if it is the last of its siblings that starts at or
+// before the position.
+func (w *Workspace) NodeAt(path ast.Path, position ast.Position) (NodeAtResult, bool) {
+	doc, ok := w.nodeTracker.astRoot.Documents[path]
+	if !ok {
+		return NodeAtResult{}, false
+	}
+
+	var cur ast.MlgNodeKind
+	for _, item := range doc.Items {
+		if item != nil && !isPositionAfter(item.GetCommonMetaData().Start, position) {
+			cur = item
+		}
+	}
+
+	chain := make([]ast.MlgNodeKind, 0)
+	innermost := -1
+	for cur != nil {
+		if _, ok := cur.(ast.FormulationNodeKind); ok {
+			innermost = len(chain)
+		}
+		chain = append(chain, cur)
+		cur = getChildAt(cur, position)
+	}
+	if innermost < 0 {
+		return NodeAtResult{}, false
+	}
+
+	phase4Doc, _ := w.nodeTracker.GetDocumentAt(path)
+	keysToPhase4 := make(map[int]phase4.Node)
+	for _, node := range phase4Doc.Nodes {
+		indexPhase4Keys(node, keysToPhase4)
+	}
+
+	phase4Enclosing := make([]phase4.Node, 0)
+	for _, node := range chain[:innermost] {
+		if _, ok := node.(ast.StructuralNodeKind); !ok {
+			continue
+		}
+		if phase4Node, ok := keysToPhase4[node.GetCommonMetaData().Key]; ok {
+			phase4Enclosing = append(phase4Enclosing, phase4Node)
+		}
+	}
+
+	return NodeAtResult{
+		Node:            chain[innermost].(ast.FormulationNodeKind),
+		Enclosing:       chain[:innermost],
+		Phase4Enclosing: phase4Enclosing,
+	}, true
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func getChildAt(node ast.MlgNodeKind, position ast.Position) ast.MlgNodeKind {
+	var result ast.MlgNodeKind
+	var resultStart ast.Position
+	node.ForEach(func(child ast.MlgNodeKind) {
+		if child == nil {
+			return
+		}
+		childStart, ok := getNodeStart(child)
+		if !ok || isPositionAfter(childStart, position) {
+			return
+		}
+		if rawText, ok := getFormulationRawText(child); ok &&
+			!formulationContains(childStart, rawText, position) {
+			return
+		}
+		if result == nil || isPositionAfter(childStart, resultStart) {
+			result = child
+			resultStart = childStart
+		}
+	})
+	return result
+}
+
+// getNodeStart returns where the node starts in the source.  Some nodes, such as `is`
+// expressions, do not record a position, and so the start of their first child that
+// does is used.  Nodes added when normalizing the tree do not have a position at all.
+func getNodeStart(node ast.MlgNodeKind) (ast.Position, bool) {
+	start := node.GetCommonMetaData().Start
+	if start != (ast.Position{}) {
+		return start, true
+	}
+	found := false
+	node.ForEach(func(child ast.MlgNodeKind) {
+		if found || child == nil {
+			return
+		}
+		start, found = getNodeStart(child)
+	})
+	return start, found
+}
+
+// getFormulationRawText returns the text of the formulation, target, spec, or alias node
+// since the text can be used to determine where the node ends.
+func getFormulationRawText(node ast.MlgNodeKind) (string, bool) {
+	switch n := node.(type) {
+	case *ast.Formulation[ast.FormulationNodeKind]:
+		return n.RawText, true
+	case *ast.Target:
+		return n.RawText, true
+	case *ast.Spec:
+		return n.RawText, true
+	case *ast.Alias:
+		return n.RawText, true
+	default:
+		return "", false
+	}
+}
+
+func formulationContains(start ast.Position, rawText string, position ast.Position) bool {
+	lines := strings.Split(rawText, "\n")
+	if len(lines) == 1 {
+		return position.Row == start.Row && position.Column >= start.Column &&
+			position.Column <= start.Column+len(lines[0])
+	}
+	// the indentation of the lines after the first line is not known
+	return !isPositionAfter(start, position) && position.Row <= start.Row+len(lines)-1
+}
+
+func isPositionAfter(a ast.Position, b ast.Position) bool {
+	return a.Row > b.Row || (a.Row == b.Row && a.Column > b.Column)
+}
+
+func indexPhase4Keys(node phase4.Node, keysToPhase4 map[int]phase4.Node) {
+	switch n := node.(type) {
+	case *phase4.Group:
+		keysToPhase4[n.MetaData.Key] = n
+	case *phase4.Section:
+		keysToPhase4[n.MetaData.Key] = n
+	case *phase4.Argument:
+		keysToPhase4[n.MetaData.Key] = n
+	case *phase4.TextArgumentData:
+		keysToPhase4[n.MetaData.Key] = n
+	case *phase4.FormulationArgumentData:
+		keysToPhase4[n.MetaData.Key] = n
+	case *phase4.ArgumentTextArgumentData:
+		keysToPhase4[n.MetaData.Key] = n
+	case *phase4.TextBlock:
+		keysToPhase4[n.MetaData.Key] = n
+	default:
+		return
+	}
+	switch node.(type) {
+	case *phase4.Group, *phase4.Section, *phase4.Argument:
+		for i := 0; i < node.Size(); i++ {
+			indexPhase4Keys(node.ChildAt(i), keysToPhase4)
+		}
+	}
+}
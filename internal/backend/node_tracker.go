@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"mathlingua/internal/ast"
 	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/formulation"
 	"mathlingua/internal/frontend/structural/phase4"
 	"mathlingua/internal/mlglib"
 )
@@ -37,11 +38,20 @@ type NodeTracker struct {
 	phase4Entries map[string]phase4.TopLevelNodeKind
 	// map ids to phase5 top-level types
 	topLevelEntries map[string]ast.TopLevelItemKind
+	// the operator precedences declared in the workspace
+	operators *formulation.OperatorTable
+	// the normalizations of Unicode symbols in formulations or nil to use the default
+	unicodeTable *formulation.UnicodeTable
 }
 
-func NewNodeTracker(contents []PathLabelContent, tracker *frontend.DiagnosticTracker) *NodeTracker {
+func NewNodeTracker(
+	contents []PathLabelContent,
+	tracker *frontend.DiagnosticTracker,
+	unicodeTable *formulation.UnicodeTable,
+) *NodeTracker {
 	nt := NodeTracker{
 		tracker:         tracker,
+		unicodeTable:    unicodeTable,
 		signaturesToIds: make(map[string]string, 0),
 		phase4Entries:   make(map[string]phase4.TopLevelNodeKind, 0),
 		topLevelEntries: make(map[string]ast.TopLevelItemKind, 0),
@@ -87,7 +97,7 @@ func (nt *NodeTracker) initialize(contents []PathLabelContent) {
 			contentMap[pair.Path] = *pair.Content
 		}
 	}
-	nt.phase4Root, nt.astRoot = ParseRoot(contentMap, nt.tracker)
+	nt.phase4Root, nt.astRoot, nt.operators = ParseRoot(contentMap, nt.tracker, nt.unicodeTable)
 	nt.normalizeAst()
 	nt.initializeSignaturesToIds()
 	nt.initializePhase4Entries()
@@ -121,6 +131,7 @@ func (w *NodeTracker) initializeSignaturesToIds() {
 					w.tracker.Append(frontend.Diagnostic{
 						Type:     frontend.Error,
 						Origin:   frontend.BackendOrigin,
+						Code:     frontend.DuplicateSignatureCode,
 						Message:  fmt.Sprintf("Duplicate defined signature %s", sig),
 						Path:     path,
 						Position: item.GetCommonMetaData().Start,
@@ -70,6 +70,7 @@ func AppendMetaIds(startText string) (string, error) {
 			strings.HasPrefix(cur, "Conjecture:") ||
 			strings.HasPrefix(cur, "Theorem:") ||
 			strings.HasPrefix(cur, "Topic:") ||
+			strings.HasPrefix(cur, "Note:") ||
 			strings.HasPrefix(cur, "Resource:") ||
 			strings.HasPrefix(cur, "Person:") ||
 			strings.HasPrefix(cur, "Specify:") ||
@@ -0,0 +1,209 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/structural/phase4"
+)
+
+type Note struct {
+	Id      string
+	Content string
+	// the signature in the note's `on:` section or the empty string if the note
+	// does not have an `on:` section
+	On   string
+	Path ast.Path
+}
+
+// IsViewable returns false if the item has a `Viewable:` section with the value "false".
+func IsViewable(item ast.TopLevelItemKind) bool {
+	viewable := getViewableSection(item)
+	return viewable == nil || viewable.Viewable.RawText != "false"
+}
+
+// GetNotes returns the viewable `Note:` entries that have an `on:` section that refers
+// to an entry in the workspace, keyed by the id of the entry.
+func (w *Workspace) GetNotes() map[string][]Note {
+	result := make(map[string][]Note)
+	for _, path := range getSortedPaths(w.nodeTracker.astRoot.Documents) {
+		for _, item := range w.nodeTracker.astRoot.Documents[path].Items {
+			note, ok := item.(*ast.NoteGroup)
+			if !ok || !IsViewable(note) {
+				continue
+			}
+			on, ok := getNoteOnSignature(note)
+			if !ok {
+				continue
+			}
+			entryId, ok := w.nodeTracker.GetIdForSignature(on)
+			if !ok {
+				continue
+			}
+			id, _ := GetAstMetaId(note)
+			result[entryId] = append(result[entryId], Note{
+				Id:      id,
+				Content: note.Note.Note.RawText,
+				On:      on,
+				Path:    path,
+			})
+		}
+	}
+	return result
+}
+
+// GetNotesOnDocument returns the notes on the entries in the given document keyed by the
+// id of the entry.
+func (w *Workspace) GetNotesOnDocument(doc phase4.Document) map[string][]Note {
+	allNotes := w.GetNotes()
+	result := make(map[string][]Note)
+	for _, node := range doc.Nodes {
+		if group, ok := node.(*phase4.Group); ok {
+			if notes, ok := allNotes[group.MetaData.Id]; ok {
+				result[group.MetaData.Id] = notes
+			}
+		}
+	}
+	return result
+}
+
+// GetViewableDocumentAt returns the rendered document at the given path without the
+// entries that are not viewable and without the notes that are shown beside the entry
+// they are on.
+func (w *Workspace) GetViewableDocumentAt(
+	path ast.Path,
+) (phase4.Document, []frontend.Diagnostic) {
+	doc, astDoc, diagnostics := w.GetDocumentAt(path)
+
+	hiddenKeys := make(map[int]bool)
+	for _, item := range astDoc.Items {
+		if item == nil {
+			continue
+		}
+		if !IsViewable(item) || w.isAttachedNote(item) {
+			hiddenKeys[item.GetCommonMetaData().Key] = true
+		}
+	}
+
+	nodes := make([]phase4.TopLevelNodeKind, 0, len(doc.Nodes))
+	for _, node := range doc.Nodes {
+		if group, ok := node.(*phase4.Group); ok && hiddenKeys[group.MetaData.Key] {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	doc.Nodes = nodes
+	return doc, diagnostics
+}
+
+// IsViewableEntry returns whether the entry with the given id exists and is viewable.
+func (w *Workspace) IsViewableEntry(id string) bool {
+	_, item, err := w.nodeTracker.GetEntryById(id)
+	return err == nil && IsViewable(item)
+}
+
+// IsViewableSignature returns whether the entry with the given signature exists and is
+// viewable.
+func (w *Workspace) IsViewableSignature(signature string) bool {
+	id, ok := w.nodeTracker.GetIdForSignature(signature)
+	return ok && w.IsViewableEntry(id)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// findUnknownNoteTargets reports an error for each `Note:` entry whose `on:` section
+// refers to a signature that is not the signature of an entry in the workspace.
+func (sm *SignatureManager) findUnknownNoteTargets() {
+	for path, doc := range sm.nodeTracker.astRoot.Documents {
+		for _, item := range doc.Items {
+			note, ok := item.(*ast.NoteGroup)
+			if !ok {
+				continue
+			}
+			on, ok := getNoteOnSignature(note)
+			if !ok {
+				continue
+			}
+			if _, ok := sm.nodeTracker.signaturesToIds[on]; !ok {
+				sm.diasnosticTracker.Append(frontend.Diagnostic{
+					Type:        frontend.Error,
+					Origin:      frontend.BackendOrigin,
+					Code:        frontend.UnrecognizedSignatureCode,
+					Message:     fmt.Sprintf("Unrecognized note target %s", on),
+					Path:        path,
+					Position:    note.On.On.CommonMetaData.Start,
+					Suggestions: sm.GetSuggestions(on),
+				})
+			}
+		}
+	}
+}
+
+func (w *Workspace) isAttachedNote(item ast.TopLevelItemKind) bool {
+	note, ok := item.(*ast.NoteGroup)
+	if !ok {
+		return false
+	}
+	on, ok := getNoteOnSignature(note)
+	if !ok {
+		return false
+	}
+	_, ok = w.nodeTracker.GetIdForSignature(on)
+	return ok
+}
+
+func getNoteOnSignature(note *ast.NoteGroup) (string, bool) {
+	if note.On == nil {
+		return "", false
+	}
+	sig, ok := note.On.On.Root.(*ast.Signature)
+	if !ok {
+		return "", false
+	}
+	return sig.ToCode(ast.NoOp), true
+}
+
+func getViewableSection(item ast.TopLevelItemKind) *ast.ViewableSection {
+	switch n := item.(type) {
+	case *ast.DescribesGroup:
+		return n.Viewable
+	case *ast.DefinesGroup:
+		return n.Viewable
+	case *ast.CapturesGroup:
+		return n.Viewable
+	case *ast.StatesGroup:
+		return n.Viewable
+	case *ast.AxiomGroup:
+		return n.Viewable
+	case *ast.ConjectureGroup:
+		return n.Viewable
+	case *ast.TheoremGroup:
+		return n.Viewable
+	case *ast.LemmaGroup:
+		return n.Viewable
+	case *ast.CorollaryGroup:
+		return n.Viewable
+	case *ast.TopicGroup:
+		return n.Viewable
+	case *ast.NoteGroup:
+		return n.Viewable
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend/structural/phase4"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const notesInput = `
+[\group]
+Describes: G
+------------------------------------------
+Id: "1"
+
+
+Note: "Groups describe symmetry."
+on: '\:group'
+------------------------------------------
+Id: "2"
+
+
+Note: "Some general remark."
+------------------------------------------
+Id: "3"
+
+
+Theorem:
+then: 'x is \unknown'
+Viewable: "false"
+------------------------------------------
+Id: "4"
+
+
+Note: "A hidden note."
+on: '\:group'
+Viewable: "false"
+------------------------------------------
+Id: "5"
+`
+
+func TestGetNotes(t *testing.T) {
+	workspace := newTestWorkspace(notesInput)
+
+	assert.Equal(t, map[string][]Note{
+		"1": {
+			{
+				Id:      "2",
+				Content: "Groups describe symmetry.",
+				On:      "\\:group",
+				Path:    ast.ToPath("test.math"),
+			},
+		},
+	}, workspace.GetNotes())
+}
+
+func TestGetViewableDocumentAt(t *testing.T) {
+	workspace := newTestWorkspace(notesInput)
+
+	doc, _ := workspace.GetViewableDocumentAt(ast.ToPath("test.math"))
+	ids := make([]string, 0)
+	for _, node := range doc.Nodes {
+		if group, ok := node.(*phase4.Group); ok {
+			ids = append(ids, group.MetaData.Id)
+		}
+	}
+	assert.Equal(t, []string{"1", "3"}, ids)
+	assert.True(t, workspace.IsViewableEntry("1"))
+	assert.False(t, workspace.IsViewableEntry("4"))
+}
+
+func TestNotViewableEntriesAreChecked(t *testing.T) {
+	workspace := newTestWorkspace(notesInput)
+
+	messages := make([]string, 0)
+	for _, diag := range workspace.Check().Diagnostics {
+		messages = append(messages, diag.Message)
+	}
+	assert.Contains(t, messages, "Unrecognized signature \\:unknown")
+}
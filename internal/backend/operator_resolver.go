@@ -0,0 +1,418 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/formulation"
+	"slices"
+	"strings"
+)
+
+// OperatorResolver determines which operator provided through a Provides: section an
+// operator call, for example `a + b`, refers to using the types of the operator's operands.
+// The signature of the entry providing the operator is recorded in the FormulationMetaData
+// of the operator call.
+type OperatorResolver struct {
+	// the tracker used to record diagnostics
+	tracker     *frontend.DiagnosticTracker
+	nodeTracker *NodeTracker
+	// map signatures to summaries of the types they describe
+	typeSummaries map[string]ast.TypeSummary
+	// map operators to the entries that provide them
+	providers map[operatorKey][]providedOperator
+}
+
+func NewOperatorResolver(
+	nodeTracker *NodeTracker,
+	tracker *frontend.DiagnosticTracker,
+) *OperatorResolver {
+	resolver := OperatorResolver{
+		tracker:       tracker,
+		nodeTracker:   nodeTracker,
+		typeSummaries: make(map[string]ast.TypeSummary),
+		providers:     make(map[operatorKey][]providedOperator),
+	}
+	resolver.initializeSummaries()
+	resolver.resolveOperators()
+	return &resolver
+}
+
+// GetProvidedOperator returns the provided operator the given resolved operator call refers to.
+func (r *OperatorResolver) GetProvidedOperator(
+	node ast.FormulationNodeKind,
+) (ast.ProvidedOperatorSummary, bool) {
+	signature := node.GetFormulationMetaData().ResolvedSignature
+	key, ok := getOperatorKey(node)
+	if signature == "" || !ok {
+		return ast.ProvidedOperatorSummary{}, false
+	}
+	for _, provider := range r.providers[key] {
+		if provider.signature == signature {
+			return provider.summary, true
+		}
+	}
+	return ast.ProvidedOperatorSummary{}, false
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type providedOperator struct {
+	// the signature of the entry that provides the operator
+	signature string
+	summary   ast.ProvidedOperatorSummary
+}
+
+// map names to the signatures of the types they are described to be
+type typeScope map[string][]string
+
+// bind returns a copy of the scope without the names bound by the given targets so that
+// the types recorded for a bound name do not include the types of an outer name.
+func (s typeScope) bind(targets []ast.Target) typeScope {
+	result := make(typeScope, len(s))
+	for name, types := range s {
+		result[name] = slices.Clip(types)
+	}
+	for _, target := range targets {
+		forEachName(target.Root, func(name *ast.NameForm) {
+			delete(result, name.Text)
+		})
+	}
+	return result
+}
+
+func (r *OperatorResolver) initializeSummaries() {
+	for _, path := range getSortedPaths(r.nodeTracker.astRoot.Documents) {
+		for _, item := range r.nodeTracker.astRoot.Documents[path].Items {
+			signature, ok := GetSignatureStringFromTopLevel(item)
+			if !ok {
+				continue
+			}
+			summary, ok := GetTypeSummary(item, r.tracker)
+			if !ok || summary == nil {
+				continue
+			}
+			r.typeSummaries[signature] = *summary
+			for _, op := range summary.Operators {
+				lhs, ok := getAliasLhs(op.Symbol)
+				if !ok {
+					continue
+				}
+				key, ok := getOperatorKey(lhs)
+				if !ok {
+					continue
+				}
+				r.providers[key] = append(r.providers[key], providedOperator{
+					signature: signature,
+					summary:   op,
+				})
+			}
+		}
+	}
+}
+
+func (r *OperatorResolver) resolveOperators() {
+	if len(r.providers) == 0 {
+		return
+	}
+	for _, path := range getSortedPaths(r.nodeTracker.astRoot.Documents) {
+		for _, item := range r.nodeTracker.astRoot.Documents[path].Items {
+			scope := make(typeScope)
+			r.initializeScope(item, scope)
+			r.resolveIn(path, item, scope)
+		}
+	}
+}
+
+// initializeScope records the type of each name described by an `is` statement in the
+// given top-level item, outside of any forAll:, exists:, or existsUnique:, as well as the
+// type of the item introduced by a Describes: or Defines:.
+func (r *OperatorResolver) initializeScope(item ast.TopLevelItemKind, scope typeScope) {
+	switch entry := item.(type) {
+	case *ast.DescribesGroup:
+		if name, ok := getTargetName(entry.Describes.Describes); ok {
+			if signature, ok := GetSignatureStringFromTopLevel(entry); ok {
+				scope[name] = append(scope[name], signature)
+			}
+		}
+	case *ast.DefinesGroup:
+		if name, ok := getTargetName(entry.Defines.Defines); ok {
+			if signature, ok := GetSignatureStringFromTopLevel(entry); ok {
+				scope[name] = append(scope[name], r.typeSummaries[signature].Is...)
+			}
+		}
+	}
+	r.recordIsStatements(item, scope)
+}
+
+// recordIsStatements records the `is` statements in the given node except for those in a
+// nested forAll:, exists:, or existsUnique:, which are recorded in the scope of the group.
+func (r *OperatorResolver) recordIsStatements(node ast.MlgNodeKind, scope typeScope) {
+	if node == nil {
+		return
+	}
+	if is, ok := node.(*ast.IsExpression); ok {
+		signatures := getKindSignatures(is.Rhs)
+		for _, lhs := range is.Lhs {
+			if name, ok := lhs.(*ast.NameForm); ok {
+				scope[name.Text] = append(scope[name.Text], signatures...)
+			}
+		}
+	}
+	node.ForEach(func(subNode ast.MlgNodeKind) {
+		if _, ok := getBoundTargets(subNode); !ok {
+			r.recordIsStatements(subNode, scope)
+		}
+	})
+}
+
+func (r *OperatorResolver) resolveIn(path ast.Path, node ast.MlgNodeKind, scope typeScope) {
+	if node == nil {
+		return
+	}
+	if formulationNode, ok := node.(ast.FormulationNodeKind); ok {
+		r.inferTypes(path, formulationNode, scope)
+		return
+	}
+	if targets, ok := getBoundTargets(node); ok {
+		scope = scope.bind(targets)
+		r.recordIsStatements(node, scope)
+	}
+	node.ForEach(func(subNode ast.MlgNodeKind) {
+		r.resolveIn(path, subNode, scope)
+	})
+}
+
+// inferTypes resolves the operators in the given node from the bottom up and
+// returns the signatures of the types of the node, if they can be determined.
+func (r *OperatorResolver) inferTypes(
+	path ast.Path,
+	node ast.FormulationNodeKind,
+	scope typeScope,
+) []string {
+	if node == nil {
+		return nil
+	}
+	switch n := node.(type) {
+	case *ast.NameForm:
+		return r.getAllTypes(scope[n.Text])
+	case *ast.TupleExpression:
+		if len(n.Args) == 1 {
+			// (x) has the same type as x
+			return r.inferTypes(path, n.Args[0], scope)
+		}
+	case *ast.CommandExpression:
+		r.inferChildTypes(path, n, scope)
+		if summary, ok := r.typeSummaries[GetSignatureStringFromCommand(*n)]; ok {
+			return r.getAllTypes(summary.Is)
+		}
+		return nil
+	case *ast.InfixOperatorCallExpression:
+		lhs := r.inferTypes(path, n.Lhs, scope)
+		rhs := r.inferTypes(path, n.Rhs, scope)
+		return r.resolveOperator(path, n, [][]string{lhs, rhs})
+	case *ast.PrefixOperatorCallExpression:
+		return r.resolveOperator(path, n, [][]string{r.inferTypes(path, n.Arg, scope)})
+	case *ast.PostfixOperatorCallExpression:
+		return r.resolveOperator(path, n, [][]string{r.inferTypes(path, n.Arg, scope)})
+	case *ast.MultiplexedInfixOperatorCallExpression:
+		// for `a, b < c` each of `a`, `b`, and `c` must have a type that provides `<`
+		operandTypes := make([][]string, 0)
+		for _, lhs := range n.Lhs {
+			operandTypes = append(operandTypes, r.inferTypes(path, lhs, scope))
+		}
+		for _, rhs := range n.Rhs {
+			operandTypes = append(operandTypes, r.inferTypes(path, rhs, scope))
+		}
+		return r.resolveOperator(path, n, operandTypes)
+	}
+	r.inferChildTypes(path, node, scope)
+	return nil
+}
+
+func (r *OperatorResolver) inferChildTypes(
+	path ast.Path,
+	node ast.FormulationNodeKind,
+	scope typeScope,
+) {
+	node.ForEach(func(subNode ast.MlgNodeKind) {
+		r.resolveIn(path, subNode, scope)
+	})
+}
+
+// resolveOperator records the signature of the entry providing the operator called by the
+// given node and returns the types of the result of the call.  An operator is only resolved
+// if the types of at least one of its operands are known, and a provided operator is a
+// candidate if it is provided by a type of each operand whose types are known.
+func (r *OperatorResolver) resolveOperator(
+	path ast.Path,
+	node ast.FormulationNodeKind,
+	operandTypes [][]string,
+) []string {
+	key, ok := getOperatorKey(node)
+	if !ok {
+		return nil
+	}
+	providers, ok := r.providers[key]
+	if !ok {
+		return nil
+	}
+
+	knownTypes := make([][]string, 0)
+	for _, types := range operandTypes {
+		if len(types) > 0 {
+			knownTypes = append(knownTypes, types)
+		}
+	}
+	if len(knownTypes) == 0 {
+		return nil
+	}
+
+	candidates := make([]string, 0)
+	for _, provider := range providers {
+		isCandidate := true
+		for _, types := range knownTypes {
+			if !slices.Contains(types, provider.signature) {
+				isCandidate = false
+				break
+			}
+		}
+		if isCandidate && !slices.Contains(candidates, provider.signature) {
+			candidates = append(candidates, provider.signature)
+		}
+	}
+	candidates = r.getMostSpecific(candidates)
+
+	switch len(candidates) {
+	case 0:
+		operandNames := make([]string, 0)
+		for _, types := range knownTypes {
+			operandNames = append(operandNames, types[0])
+		}
+		r.tracker.Append(frontend.Diagnostic{
+			Type:   frontend.Error,
+			Origin: frontend.BackendOrigin,
+			Code:   frontend.UnresolvedOperatorCode,
+			Message: fmt.Sprintf("No operator '%s' is provided for operands of type %s",
+				key.text, strings.Join(operandNames, " and ")),
+			Path:     path,
+			Position: node.Start(),
+		})
+		return nil
+	case 1:
+		node.GetFormulationMetaData().ResolvedSignature = candidates[0]
+		// a provided operator is assumed to produce a value of the type providing it
+		return r.getAllTypes(candidates)
+	default:
+		r.tracker.Append(frontend.Diagnostic{
+			Type:   frontend.Error,
+			Origin: frontend.BackendOrigin,
+			Code:   frontend.AmbiguousOperatorCode,
+			Message: fmt.Sprintf("The operator '%s' is ambiguous and could refer to the operator "+
+				"provided by any of %s", key.text, strings.Join(candidates, ", ")),
+			Path:     path,
+			Position: node.Start(),
+		})
+		return nil
+	}
+}
+
+// getAllTypes returns the given signatures together with the signatures of all of the types
+// they extend.  The given signatures are first in the result.
+func (r *OperatorResolver) getAllTypes(signatures []string) []string {
+	result := make([]string, 0)
+	queue := append([]string{}, signatures...)
+	for len(queue) > 0 {
+		signature := queue[0]
+		queue = queue[1:]
+		if slices.Contains(result, signature) {
+			continue
+		}
+		result = append(result, signature)
+		if summary, ok := r.typeSummaries[signature]; ok {
+			queue = append(queue, summary.Is...)
+		}
+	}
+	return result
+}
+
+// getMostSpecific removes each signature that is extended by another of the signatures
+// so that an operator provided by a type is preferred over one provided by a type it extends.
+func (r *OperatorResolver) getMostSpecific(signatures []string) []string {
+	result := make([]string, 0)
+	for _, signature := range signatures {
+		isExtended := false
+		for _, other := range signatures {
+			if other != signature && slices.Contains(r.getAllTypes([]string{other}), signature) {
+				isExtended = true
+				break
+			}
+		}
+		if !isExtended {
+			result = append(result, signature)
+		}
+	}
+	return result
+}
+
+// getBoundTargets returns the targets of the given node if it is a forAll:, exists:, or
+// existsUnique:.
+func getBoundTargets(node ast.MlgNodeKind) ([]ast.Target, bool) {
+	switch n := node.(type) {
+	case *ast.ForAllGroup:
+		return n.ForAll.Targets, true
+	case *ast.ExistsGroup:
+		return n.Exists.Targets, true
+	case *ast.ExistsUniqueGroup:
+		return n.ExistsUnique.Targets, true
+	default:
+		return nil, false
+	}
+}
+
+func getOperatorKey(node ast.FormulationNodeKind) (operatorKey, bool) {
+	if multiplexed, ok := node.(*ast.MultiplexedInfixOperatorCallExpression); ok {
+		text, ok := formulation.GetOperatorText(multiplexed.Target)
+		return operatorKey{
+			text:     text,
+			itemType: formulation.InfixOperatorType,
+		}, ok
+	}
+	text, ok := formulation.GetOperatorText(node)
+	if !ok {
+		return operatorKey{}, false
+	}
+	itemType, ok := formulation.GetOperatorItemType(node)
+	return operatorKey{
+		text:     text,
+		itemType: itemType,
+	}, ok
+}
+
+// getAliasLhs returns `x + y` for the alias `x + y :=> \plus{x, y}`.
+func getAliasLhs(alias ast.Alias) (ast.ExpressionKind, bool) {
+	switch root := alias.Root.(type) {
+	case *ast.ExpressionColonArrowItem:
+		return root.Lhs, true
+	case *ast.ExpressionColonDashArrowItem:
+		return root.Lhs, true
+	default:
+		return nil, false
+	}
+}
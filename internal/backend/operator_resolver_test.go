@@ -0,0 +1,123 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const operatorResolverInput = `
+[\integer]
+Describes: n
+Provides:
+. symbol: 'x + y :=> x'
+  written: "x? \oplus y?"
+Documented:
+. called: "integer"
+------------------------------------------
+Id: "1"
+
+
+[\matrix]
+Describes: M
+Provides:
+. symbol: 'x + y :=> x'
+  written: "x? \boxplus y?"
+Documented:
+. called: "matrix"
+------------------------------------------
+Id: "2"
+
+
+Theorem:
+given: a, b, A, B
+where:
+. 'a, b is \integer'
+. 'A, B is \matrix'
+then:
+. 'a + b'
+. 'A + B'
+------------------------------------------
+Id: "3"
+`
+
+func TestOperatorResolvedByOperandTypes(t *testing.T) {
+	tracker := frontend.NewDiagnosticTracker()
+	content := operatorResolverInput
+	workspace := NewWorkspace([]PathLabelContent{
+		{
+			Path:    ast.ToPath("test.math"),
+			Label:   "test.math",
+			Content: &content,
+		},
+	}, tracker, nil)
+	assert.Equal(t, 0, len(tracker.Diagnostics()))
+
+	_, theorem, err := workspace.nodeTracker.GetEntryById("3")
+	assert.Nil(t, err)
+	then := theorem.(*ast.TheoremGroup).Then.Clauses
+
+	intSum := then[0].(*ast.Formulation[ast.FormulationNodeKind]).Root
+	assert.Equal(t, "\\:integer", intSum.GetFormulationMetaData().ResolvedSignature)
+	assert.Equal(t, "a \\oplus b",
+		workspace.writtenResolver.formulationNodeToWritten(ast.ToPath("test.math"), intSum))
+
+	matrixSum := then[1].(*ast.Formulation[ast.FormulationNodeKind]).Root
+	assert.Equal(t, "\\:matrix", matrixSum.GetFormulationMetaData().ResolvedSignature)
+	assert.Equal(t, "A \\boxplus B",
+		workspace.writtenResolver.formulationNodeToWritten(ast.ToPath("test.math"), matrixSum))
+}
+
+func TestOperatorResolvedInScopeOfBinder(t *testing.T) {
+	tracker := frontend.NewDiagnosticTracker()
+	content := operatorResolverInput + `
+
+Theorem:
+given: a, b
+where: 'a, b is \integer'
+then:
+. forAll: a
+  where: 'a is \matrix'
+  then: 'a + a'
+. 'a + b'
+------------------------------------------
+Id: "4"
+`
+	workspace := NewWorkspace([]PathLabelContent{
+		{
+			Path:    ast.ToPath("test.math"),
+			Label:   "test.math",
+			Content: &content,
+		},
+	}, tracker, nil)
+	assert.Equal(t, 0, len(tracker.Diagnostics()))
+
+	_, theorem, err := workspace.nodeTracker.GetEntryById("4")
+	assert.Nil(t, err)
+	then := theorem.(*ast.TheoremGroup).Then.Clauses
+
+	forAll := then[0].(*ast.ForAllGroup)
+	innerSum := forAll.Then.Clauses[0].(*ast.Formulation[ast.FormulationNodeKind]).Root
+	assert.Equal(t, "\\:matrix", innerSum.GetFormulationMetaData().ResolvedSignature)
+
+	outerSum := then[1].(*ast.Formulation[ast.FormulationNodeKind]).Root
+	assert.Equal(t, "\\:integer", outerSum.GetFormulationMetaData().ResolvedSignature)
+}
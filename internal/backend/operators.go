@@ -0,0 +1,191 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/formulation"
+	"mathlingua/internal/frontend/structural/phase4"
+	"mathlingua/internal/mlglib"
+	"strconv"
+)
+
+// GetOperatorTable returns the table of operator precedences and associativities declared
+// through the `precedence:` and `associativity:` sections of `symbol:` groups in the given
+// documents.  An error is reported for each declaration that conflicts with an earlier one.
+func GetOperatorTable(
+	docs map[ast.Path]phase4.Document,
+	tracker *frontend.DiagnosticTracker,
+	unicodeTable *formulation.UnicodeTable,
+) *formulation.OperatorTable {
+	table := formulation.NewOperatorTable()
+	declarations := make(map[operatorKey]operatorDeclaration)
+	for _, path := range getSortedPaths(docs) {
+		for _, node := range docs[path].Nodes {
+			if group, ok := node.(*phase4.Group); ok {
+				forEachSymbolGroup(group, func(symbolGroup *phase4.Group) {
+					decl, ok := toOperatorDeclaration(path, symbolGroup, unicodeTable)
+					if !ok {
+						return
+					}
+					if prev, exists := declarations[decl.key]; exists {
+						if prev.info != decl.info {
+							tracker.Append(frontend.Diagnostic{
+								Type:   frontend.Error,
+								Origin: frontend.BackendOrigin,
+								Code:   frontend.ConflictingOperatorCode,
+								Message: fmt.Sprintf(
+									"The precedence and associativity of '%s' conflicts with the "+
+										"declaration at %s (%d, %d)",
+									decl.key.text, prev.path, prev.position.Row+1, prev.position.Column+1),
+								Path:     path,
+								Position: decl.position,
+							})
+						}
+						return
+					}
+					declarations[decl.key] = decl
+					table.Declare(decl.key.text, decl.key.itemType, decl.info)
+				})
+			}
+		}
+	}
+	return table
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type operatorKey struct {
+	text     string
+	itemType formulation.ItemType
+}
+
+type operatorDeclaration struct {
+	key      operatorKey
+	info     formulation.OperatorInfo
+	path     ast.Path
+	position ast.Position
+}
+
+func forEachSymbolGroup(group *phase4.Group, fn func(group *phase4.Group)) {
+	if len(group.Sections) > 0 && group.Sections[0].Name == ast.LowerSymbolName {
+		fn(group)
+		return
+	}
+	for _, section := range group.Sections {
+		for _, arg := range section.Args {
+			if subGroup, ok := arg.Arg.(*phase4.Group); ok {
+				forEachSymbolGroup(subGroup, fn)
+			}
+		}
+	}
+}
+
+func toOperatorDeclaration(
+	path ast.Path,
+	group *phase4.Group,
+	unicodeTable *formulation.UnicodeTable,
+) (operatorDeclaration, bool) {
+	symbolText, ok := getSectionArgText(group, ast.LowerSymbolName)
+	if !ok {
+		return operatorDeclaration{}, false
+	}
+	precedenceText, hasPrecedence := getSectionArgText(group, ast.LowerPrecedenceName)
+	associativityText, hasAssociativity := getSectionArgText(group, ast.LowerAssociativityName)
+	if !hasPrecedence && !hasAssociativity {
+		return operatorDeclaration{}, false
+	}
+
+	// errors in the symbol are reported when the group is parsed by phase5
+	// and so a scratch tracker is used here
+	scratch := frontend.NewDiagnosticTracker()
+	root, ok := formulation.ParseExpression(
+		path, symbolText, group.MetaData.Start, scratch, mlglib.NewKeyGenerator(), nil,
+		unicodeTable)
+	if !ok {
+		return operatorDeclaration{}, false
+	}
+
+	var lhs ast.ExpressionKind
+	switch root := root.(type) {
+	case *ast.ExpressionColonArrowItem:
+		lhs = root.Lhs
+	case *ast.ExpressionColonDashArrowItem:
+		lhs = root.Lhs
+	default:
+		return operatorDeclaration{}, false
+	}
+
+	text, ok := formulation.GetOperatorText(lhs)
+	if !ok {
+		return operatorDeclaration{}, false
+	}
+	itemType, ok := formulation.GetOperatorItemType(lhs)
+	if !ok {
+		return operatorDeclaration{}, false
+	}
+
+	info := formulation.DefaultOperatorInfo(lhs, itemType)
+	if hasPrecedence {
+		precedence, err := strconv.Atoi(precedenceText)
+		if err != nil {
+			// the invalid precedence is reported by phase5
+			return operatorDeclaration{}, false
+		}
+		info.Precedence = precedence
+	}
+	if hasAssociativity {
+		switch associativityText {
+		case "left":
+			info.Associativity = formulation.LeftAssociative
+		case "right":
+			info.Associativity = formulation.RightAssociative
+		default:
+			// the invalid associativity is reported by phase5
+			return operatorDeclaration{}, false
+		}
+	}
+
+	return operatorDeclaration{
+		key: operatorKey{
+			text:     text,
+			itemType: itemType,
+		},
+		info:     info,
+		path:     path,
+		position: group.MetaData.Start,
+	}, true
+}
+
+// getSectionArgText returns the text of the single argument of the
+// section in the given group with the given name.
+func getSectionArgText(group *phase4.Group, name string) (string, bool) {
+	for _, section := range group.Sections {
+		if section.Name != name || len(section.Args) != 1 {
+			continue
+		}
+		switch arg := section.Args[0].Arg.(type) {
+		case *phase4.FormulationArgumentData:
+			return arg.Text, true
+		case *phase4.TextArgumentData:
+			return arg.Text, true
+		}
+	}
+	return "", false
+}
@@ -18,7 +18,9 @@ package backend
 
 import (
 	"mathlingua/internal/ast"
+	"mathlingua/internal/config"
 	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/formulation"
 	"mathlingua/internal/frontend/structural/phase1"
 	"mathlingua/internal/frontend/structural/phase2"
 	"mathlingua/internal/frontend/structural/phase3"
@@ -31,29 +33,37 @@ func ParseDocument(
 	text string,
 	path ast.Path,
 	tracker *frontend.DiagnosticTracker,
+	unicodeTable *formulation.UnicodeTable,
 ) (*phase4.Document, *ast.Document) {
-
-	lexer1 := phase1.NewLexer(text, path, tracker)
-	lexer2 := phase2.NewLexer(lexer1, path, tracker)
-	lexer3 := phase3.NewLexer(lexer2, path, tracker)
-
-	phase4Doc := phase4.Parse(lexer3, path, tracker)
-	astDoc, _ := phase5.Parse(phase4Doc, path, tracker, mlglib.NewKeyGenerator())
-
+	phase4Doc := parsePhase4Document(text, path, tracker)
+	operators := GetOperatorTable(map[ast.Path]phase4.Document{
+		path: phase4Doc,
+	}, tracker, unicodeTable)
+	astDoc, _ := phase5.Parse(
+		phase4Doc, path, tracker, mlglib.NewKeyGenerator(), operators, unicodeTable)
 	return &phase4Doc, &astDoc
 }
 
+// ParseRoot parses the given texts where the operator precedences declared in any of
+// the texts are used when parsing the formulations in all of the texts.  Unicode symbols
+// in formulations are normalized using the given table, or the default table if it is nil.
 func ParseRoot(
 	texts map[ast.Path]string,
 	tracker *frontend.DiagnosticTracker,
-) (*phase4.Root, *ast.Root) {
+	unicodeTable *formulation.UnicodeTable,
+) (*phase4.Root, *ast.Root, *formulation.OperatorTable) {
 	phase4Docs := make(map[ast.Path]phase4.Document, 0)
 	astDocs := make(map[ast.Path]ast.Document, 0)
 
 	for path, content := range texts {
-		phase4Doc, astDoc := ParseDocument(content, path, tracker)
-		astDocs[path] = *astDoc
-		phase4Docs[path] = *phase4Doc
+		phase4Docs[path] = parsePhase4Document(content, path, tracker)
+	}
+
+	operators := GetOperatorTable(phase4Docs, tracker, unicodeTable)
+	for path, phase4Doc := range phase4Docs {
+		astDoc, _ := phase5.Parse(
+			phase4Doc, path, tracker, mlglib.NewKeyGenerator(), operators, unicodeTable)
+		astDocs[path] = astDoc
 	}
 
 	phase4Root := phase4.Root{
@@ -81,5 +91,32 @@ func ParseRoot(
 		},
 	}
 
-	return &phase4Root, &astRoot
+	return &phase4Root, &astRoot, operators
+}
+
+// GetUnicodeTable returns the default normalizations of Unicode symbols in formulations
+// together with the normalizations configured in the given mlg.conf, where the configured
+// normalizations take precedence.
+func GetUnicodeTable(conf config.MlgConfig) *formulation.UnicodeTable {
+	table := formulation.DefaultUnicodeTable()
+	for symbol, ascii := range conf.Unicode {
+		table.Add(symbol, ascii)
+	}
+	return table
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func parsePhase4Document(
+	text string,
+	path ast.Path,
+	tracker *frontend.DiagnosticTracker,
+) phase4.Document {
+	lexer1, comments := phase1.NewLexerWithComments(text, path, tracker)
+	lexer2 := phase2.NewLexer(lexer1, path, tracker)
+	lexer3 := phase3.NewLexer(lexer2, path, tracker)
+
+	phase4Doc := phase4.Parse(lexer3, path, tracker)
+	phase4Doc.Comments = comments
+	return phase4Doc
 }
@@ -65,7 +65,8 @@ func ToFormPattern(item ast.StructuralFormKind) ast.FormPatternKind {
 		return ToFunctionLiteralFormPattern(*n)
 	case *ast.StructuralColonEqualsForm:
 		return ToStructuralColonEqualsPattern(*n)
-	// TODO: add StructuralColonEqualsColonForm case
+	case *ast.StructuralColonEqualsColonForm:
+		return ToStructuralColonEqualsColonPattern(*n)
 	default:
 		panic("Could not process a pattern for " +
 			item.ToCode(func(node ast.MlgNodeKind) (string, bool) { return "", false }))
@@ -297,7 +298,14 @@ func ToInfixCommandPattern(id ast.InfixCommandOperatorId) ast.InfixCommandPatter
 	}
 }
 
-// TODO: add ToStructuralColonEqualsColonPattern
+func ToStructuralColonEqualsColonPattern(
+	colonEqualsColon ast.StructuralColonEqualsColonForm,
+) *ast.StructuralColonEqualsColonPattern {
+	return &ast.StructuralColonEqualsColonPattern{
+		Lhs: toStructuralColonEqualsColonItemPattern(colonEqualsColon.Lhs),
+		Rhs: toStructuralColonEqualsColonItemPattern(colonEqualsColon.Rhs),
+	}
+}
 
 func ToStructuralColonEqualsPattern(
 	colonEquals ast.StructuralColonEqualsForm,
@@ -310,6 +318,22 @@ func ToStructuralColonEqualsPattern(
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
+func toStructuralColonEqualsColonItemPattern(
+	item ast.StructuralColonEqualsColonFormItemKind,
+) ast.FormPatternKind {
+	switch n := item.(type) {
+	case *ast.FunctionForm:
+		return ToFunctionFormPattern(*n)
+	case *ast.ExpressionForm:
+		return ToExpressionFormPattern(*n)
+	case *ast.TupleForm:
+		return ToTupleFormPattern(*n)
+	default:
+		panic("Could not process a pattern for " +
+			item.ToCode(func(node ast.MlgNodeKind) (string, bool) { return "", false }))
+	}
+}
+
 func toFormPatterns(items []ast.StructuralFormKind) []ast.FormPatternKind {
 	patterns := make([]ast.FormPatternKind, 0)
 	for _, item := range items {
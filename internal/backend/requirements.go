@@ -17,8 +17,10 @@
 package backend
 
 import (
+	"fmt"
 	"mathlingua/internal/ast"
 	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/structural/phase4"
 )
 
 func CheckRequirements(
@@ -169,6 +171,37 @@ func checkIsExpression(
 	}
 }
 
+// CheckIds reports the top-level entries of the given document that can have an Id: section
+// but do not have one.
+func CheckIds(path ast.Path, doc *phase4.Document, tracker *frontend.DiagnosticTracker) {
+	for _, node := range doc.Nodes {
+		if group, ok := node.(*phase4.Group); ok && isMissingId(group) {
+			tracker.Append(newMissingIdDiagnostic(path, group))
+		}
+	}
+}
+
+func isMissingId(group *phase4.Group) bool {
+	if len(group.Sections) == 0 || findSection(group, ast.UpperIdName) != nil {
+		return false
+	}
+	pattern := getSectionPattern(group, topLevelSectionPatterns)
+	return pattern != nil && containsSection(pattern, ast.UpperIdQuestionName)
+}
+
+func newMissingIdDiagnostic(path ast.Path, group *phase4.Group) frontend.Diagnostic {
+	return frontend.Diagnostic{
+		Type:     frontend.Warning,
+		Origin:   frontend.BackendOrigin,
+		Code:     frontend.MissingIdCode,
+		Message:  fmt.Sprintf("%s: does not have an Id: section", group.Sections[0].Name),
+		Path:     path,
+		Position: group.MetaData.Start,
+	}
+}
+
+const givenIfConflictMessage = "An if: section cannot be specified if a given: section is specified"
+
 func checkResultGivenSuchThatIf(
 	path ast.Path,
 	position ast.Position,
@@ -186,11 +219,7 @@ func checkResultGivenSuchThatIf(
 	}
 
 	if givenSection != nil && ifSection != nil {
-		appendError(
-			path,
-			position,
-			"An if: section cannot be specified if a given: section is specified",
-			tracker)
+		appendError(path, position, givenIfConflictMessage, tracker)
 	}
 }
 
@@ -203,6 +232,7 @@ func appendError(
 	tracker.Append(frontend.Diagnostic{
 		Type:     frontend.Error,
 		Origin:   frontend.BackendOrigin,
+		Code:     frontend.InvalidRequirementCode,
 		Message:  message,
 		Position: potition,
 		Path:     path,
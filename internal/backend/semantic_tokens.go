@@ -0,0 +1,412 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/formulation"
+	"mathlingua/internal/frontend/structural/phase1"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+type SemanticTokenType string
+
+const (
+	SectionToken           SemanticTokenType = "section"
+	GroupIdToken           SemanticTokenType = "groupId"
+	BinderToken            SemanticTokenType = "binder"
+	VariableToken          SemanticTokenType = "variable"
+	NameToken              SemanticTokenType = "name"
+	CommandToken           SemanticTokenType = "command"
+	UnresolvedCommandToken SemanticTokenType = "unresolvedCommand"
+	BuiltinToken           SemanticTokenType = "builtin"
+	OperatorToken          SemanticTokenType = "operator"
+	KeywordToken           SemanticTokenType = "keyword"
+	TextToken              SemanticTokenType = "text"
+	CommentToken           SemanticTokenType = "comment"
+)
+
+type SemanticToken struct {
+	Type SemanticTokenType
+	// the zero based position of the first character of the token
+	Position ast.Position
+	// the number of characters in the token
+	Length int
+}
+
+// SemanticTokens returns the tokens of the document with the given path classified for
+// syntax highlighting, sorted by their position.  Formulations within quotes are split
+// into their own tokens, where names are classified by whether they are bound in the
+// entry that contains them and commands by whether they refer to a known entry.
+func (w *Workspace) SemanticTokens(path ast.Path) ([]SemanticToken, error) {
+	content, ok := w.getContent(path)
+	if !ok {
+		return nil, fmt.Errorf("document not found: %s", path)
+	}
+
+	s := semanticTokenizer{
+		workspace:  w,
+		positions:  NewPositionIndex(content),
+		boundNames: make([]map[string]bool, 0),
+		result:     make([]SemanticToken, 0),
+	}
+	if doc, ok := w.nodeTracker.astRoot.Documents[path]; ok {
+		for _, item := range doc.Items {
+			if item != nil {
+				s.itemStarts = append(s.itemStarts, item.GetCommonMetaData().Start)
+				s.boundNames = append(s.boundNames, getBoundNames(item))
+			}
+		}
+	}
+
+	// diagnostics are reported when the workspace is checked and not when highlighting
+	tracker := frontend.NewDiagnosticTracker()
+	lexer, comments := phase1.NewLexerWithComments(content, path, tracker)
+	for lexer.HasNext() {
+		token := lexer.Next()
+		offset := token.Position.Offset
+		length := utf8.RuneCountInString(token.Text)
+		switch token.Type {
+		case ast.Name:
+			s.append(SectionToken, offset, length)
+		case ast.Id:
+			// include the surrounding [ and ]
+			s.append(GroupIdToken, offset, length+2)
+		case ast.Text:
+			// include the surrounding quotes
+			s.append(TextToken, offset, length+2)
+		case ast.TextBlock:
+			// include the surrounding ::
+			s.append(TextToken, offset, length+4)
+		case ast.ArgumentText:
+			s.appendFormulation(path, token.Text, offset, true)
+		case ast.FormulationTokenType:
+			// the formulation starts after the opening quote
+			s.appendFormulation(path, token.Text, offset+1, false)
+		}
+	}
+	for _, comment := range comments {
+		// the lines of dashes separating an entry from its metadata are lexed as comments
+		if strings.Trim(comment.Text, "-") == "" {
+			continue
+		}
+		s.append(CommentToken, comment.Position.Offset,
+			utf8.RuneCountInString(comment.Text))
+	}
+
+	sort.SliceStable(s.result, func(i, j int) bool {
+		return s.result[i].Position.Offset < s.result[j].Position.Offset
+	})
+	return s.result, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+var builtinCommands = map[string]bool{
+	"abstract":        true,
+	"specification":   true,
+	"statement":       true,
+	"expression":      true,
+	ast.LowerTypeName: true,
+}
+
+type semanticTokenizer struct {
+	workspace *Workspace
+	// the zero based position of each byte offset in the document
+	positions  PositionIndex
+	itemStarts []ast.Position
+	// the names bound in each top-level item in the order of itemStarts
+	boundNames []map[string]bool
+	result     []SemanticToken
+}
+
+func (s *semanticTokenizer) append(tokenType SemanticTokenType, offset int, length int) {
+	if offset < 0 || offset >= len(s.positions) || length <= 0 {
+		return
+	}
+	position := s.positions[offset]
+	if n := len(s.result); n > 0 && isCommandTokenType(tokenType) &&
+		s.result[n-1].Type == tokenType {
+		// join adjacent parts of a command, such as `\` and `function` in `\function`
+		prev := &s.result[n-1]
+		if prev.Position.Row == position.Row &&
+			prev.Position.Column+prev.Length == position.Column {
+			prev.Length += length
+			return
+		}
+	}
+	s.result = append(s.result, SemanticToken{
+		Type:     tokenType,
+		Position: position,
+		Length:   length,
+	})
+}
+
+// appendFormulation appends the tokens of the given formulation text that starts at the
+// given offset in the document.  If isTarget is true, the text is an argument of a
+// section that introduces names, such as `given: x, y`.
+func (s *semanticTokenizer) appendFormulation(
+	path ast.Path,
+	text string,
+	start int,
+	isTarget bool,
+) {
+	if start < 0 || start >= len(s.positions) {
+		return
+	}
+	bound := s.getBoundNamesAt(s.positions[start])
+
+	lexer := formulation.NewLexer(
+		path, text, frontend.NewDiagnosticTracker(), s.workspace.nodeTracker.unicodeTable)
+	tokens := make([]ast.Token, 0)
+	for lexer.HasNext() {
+		tokens = append(tokens, lexer.Next())
+	}
+
+	commandTypes := s.getCommandTokenTypes(tokens)
+	for i, token := range tokens {
+		offset := start + token.Position.Offset
+		length := getSourceLength(text, tokens, i)
+		if tokenType, ok := commandTypes[i]; ok {
+			s.append(tokenType, offset, length)
+			continue
+		}
+		switch token.Type {
+		case ast.Name:
+			if isTarget {
+				s.append(BinderToken, offset, length)
+			} else if bound[token.Text] {
+				s.append(VariableToken, offset, length)
+			} else {
+				s.append(NameToken, offset, length)
+			}
+		case ast.Is, ast.Extends, ast.As, ast.At:
+			s.append(KeywordToken, offset, length)
+		case ast.Operator, ast.ColonEquals, ast.ColonEqualsColon, ast.ColonArrow,
+			ast.ColonDashArrow, ast.BarRightDashArrow:
+			s.append(OperatorToken, offset, length)
+		case ast.Text:
+			// include the surrounding quotes
+			s.append(TextToken, offset, utf8.RuneCountInString(token.Text)+2)
+		}
+	}
+}
+
+// getSourceLength returns the number of characters in the given text that are covered by
+// the token at the given index.  The lexer normalizes unicode symbols, for example `α` to
+// `alpha` and `∈` to `\.in./`, where every token of the normalized form has the offset of
+// the symbol.  Thus the first of those tokens covers the symbol and the rest cover nothing.
+func getSourceLength(text string, tokens []ast.Token, index int) int {
+	token := tokens[index]
+	offset := token.Position.Offset
+	if offset < 0 || offset >= len(text) {
+		return 0
+	}
+	if strings.HasPrefix(text[offset:], token.Text) {
+		return utf8.RuneCountInString(token.Text)
+	}
+	if index > 0 && tokens[index-1].Position.Offset == offset {
+		return 0
+	}
+	return 1
+}
+
+// getCommandTokenTypes returns the classification of the tokens that are part of the name
+// of a command, such as `\`, `function`, `:`, `on`, `:`, and `to` in
+// `\function:on{A}:to{B}` or `\`, `.`, `in`, `:`, `set`, `.`, and `/` in `\.in:set./`,
+// keyed by the index of each token.
+func (s *semanticTokenizer) getCommandTokenTypes(tokens []ast.Token) map[int]SemanticTokenType {
+	result := make(map[int]SemanticTokenType)
+	for i := range tokens {
+		cmd, ok := scanCommand(tokens, i)
+		if !ok {
+			continue
+		}
+		tokenType := UnresolvedCommandToken
+		if len(cmd.NamedGroups) == 0 && builtinCommands[cmd.mainNames(tokens)] {
+			tokenType = BuiltinToken
+		} else if _, ok := s.workspace.nodeTracker.signaturesToIds[cmd.signature(tokens)]; ok {
+			tokenType = CommandToken
+		}
+		for _, index := range cmd.Indices {
+			result[index] = tokenType
+		}
+	}
+	return result
+}
+
+// commandTokens describes the tokens that make up the name of a command in a formulation.
+type commandTokens struct {
+	// the indices of all of the tokens in the name of the command
+	Indices []int
+	// the indices of the name tokens in the main part of the name, such as `a` and `b` in
+	// `\a.b:c{x}`
+	MainNames []int
+	// the indices of the name tokens of the named groups, such as `c` in `\a.b:c{x}`
+	NamedGroups []int
+	// whether the command is an infix command, such as `\.in:set./`
+	IsInfix bool
+}
+
+// scanCommand returns the tokens in the name of the command starting at the given index
+// if the token at the index is the `\` starting a command.
+func scanCommand(tokens []ast.Token, index int) (commandTokens, bool) {
+	if index >= len(tokens) || tokens[index].Type != ast.BackSlash {
+		return commandTokens{}, false
+	}
+	cmd := commandTokens{
+		Indices: []int{index},
+	}
+	j := index + 1
+	if j < len(tokens) && tokens[j].Type == ast.Dot {
+		cmd.Indices = append(cmd.Indices, j)
+		cmd.IsInfix = true
+		j++
+	}
+	for j < len(tokens) && tokens[j].Type == ast.Name {
+		cmd.Indices = append(cmd.Indices, j)
+		cmd.MainNames = append(cmd.MainNames, j)
+		j++
+		if j+1 < len(tokens) && tokens[j].Type == ast.Dot && tokens[j+1].Type == ast.Name {
+			cmd.Indices = append(cmd.Indices, j)
+			j++
+		} else {
+			break
+		}
+	}
+	if len(cmd.MainNames) == 0 {
+		return commandTokens{}, false
+	}
+
+	j = skipGroups(tokens, j)
+	// the named groups of an infix command do not need arguments since the command ends
+	// with `./`, as in `\.in:set./`
+	for j+1 < len(tokens) && tokens[j].Type == ast.Colon && tokens[j+1].Type == ast.Name &&
+		(cmd.IsInfix || (j+2 < len(tokens) && tokens[j+2].Type == ast.LCurly)) {
+		cmd.Indices = append(cmd.Indices, j, j+1)
+		cmd.NamedGroups = append(cmd.NamedGroups, j+1)
+		j = skipGroups(tokens, j+2)
+	}
+
+	if cmd.IsInfix {
+		if j+1 >= len(tokens) || tokens[j].Type != ast.Dot || tokens[j+1].Type != ast.Slash {
+			return commandTokens{}, false
+		}
+		cmd.Indices = append(cmd.Indices, j, j+1)
+	}
+	return cmd, true
+}
+
+func (c commandTokens) mainNames(tokens []ast.Token) string {
+	names := make([]string, 0, len(c.MainNames))
+	for _, index := range c.MainNames {
+		names = append(names, tokens[index].Text)
+	}
+	return strings.Join(names, ".")
+}
+
+// signature returns the signature of the command, for example `\:a.b:c` for `\a.b:c{x}`
+// and `\:in:set:/` for `\.in:set./`.
+func (c commandTokens) signature(tokens []ast.Token) string {
+	result := "\\:" + c.mainNames(tokens)
+	for _, index := range c.NamedGroups {
+		result += ":" + tokens[index].Text
+	}
+	if c.IsInfix {
+		result += ":/"
+	}
+	return result
+}
+
+func isCommandTokenType(tokenType SemanticTokenType) bool {
+	return tokenType == CommandToken || tokenType == UnresolvedCommandToken ||
+		tokenType == BuiltinToken
+}
+
+func (s *semanticTokenizer) getBoundNamesAt(position ast.Position) map[string]bool {
+	// the id of an item is on the line before the item starts
+	next := ast.Position{
+		Row:    position.Row + 1,
+		Column: position.Column,
+	}
+	var result map[string]bool
+	for i, start := range s.itemStarts {
+		if isPositionAfter(start, next) {
+			break
+		}
+		result = s.boundNames[i]
+	}
+	return result
+}
+
+// skipGroups returns the index after the square and curly groups starting at the given
+// index, for example the index after `[x]{y}` in `[x]{y}:on{z}`.
+func skipGroups(tokens []ast.Token, index int) int {
+	for index < len(tokens) {
+		var closing ast.TokenType
+		switch tokens[index].Type {
+		case ast.LSquare:
+			closing = ast.RSquare
+		case ast.LCurly:
+			closing = ast.RCurly
+		default:
+			return index
+		}
+		opening := tokens[index].Type
+		depth := 0
+		for index < len(tokens) {
+			if tokens[index].Type == opening {
+				depth++
+			} else if tokens[index].Type == closing {
+				depth--
+			}
+			index++
+			if depth == 0 {
+				break
+			}
+		}
+	}
+	return index
+}
+
+// getBoundNames returns the names introduced in the targets and id of the given item.
+func getBoundNames(item ast.MlgNodeKind) map[string]bool {
+	result := make(map[string]bool)
+	var visit func(node ast.MlgNodeKind)
+	visit = func(node ast.MlgNodeKind) {
+		if node == nil {
+			return
+		}
+		switch n := node.(type) {
+		case *ast.Target:
+			forEachName(n.Root, func(name *ast.NameForm) {
+				result[name.Text] = true
+			})
+		case *ast.IdItem:
+			for _, name := range getIdParamNames(n) {
+				result[name] = true
+			}
+		}
+		node.ForEach(visit)
+	}
+	visit(item)
+	return result
+}
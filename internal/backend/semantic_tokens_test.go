@@ -0,0 +1,153 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"mathlingua/internal/ast"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const semanticTokensInput = `[\function:on{A}:to{B}]
+Describes: f
+when: 'f is \type'
+Documented:
+. written: "abc"
+-- a comment
+---------------
+Id: "1"
+
+
+Theorem:
+given: x, y
+then: 'x + \function:on{x}:to{z} \unknown{y}'
+Id: "2"
+`
+
+// getSemanticTokenTexts returns the type and text of each semantic token in the input.
+func getSemanticTokenTexts(t *testing.T, input string) []string {
+	workspace := newTestWorkspace(input)
+	tokens, err := workspace.SemanticTokens(ast.ToPath("test.math"))
+	assert.Nil(t, err)
+
+	lines := strings.Split(input, "\n")
+	actual := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		line := []rune(lines[token.Position.Row])
+		text := string(line[token.Position.Column : token.Position.Column+token.Length])
+		actual = append(actual, fmt.Sprintf("%s %s", token.Type, text))
+	}
+	return actual
+}
+
+func TestSemanticTokens(t *testing.T) {
+	actual := getSemanticTokenTexts(t, semanticTokensInput)
+	assert.Equal(t, []string{
+		`groupId [\function:on{A}:to{B}]`,
+		`section Describes`,
+		`binder f`,
+		`section when`,
+		`variable f`,
+		`keyword is`,
+		`builtin \type`,
+		`section Documented`,
+		`section written`,
+		`text "abc"`,
+		`comment -- a comment`,
+		`section Id`,
+		`text "1"`,
+		`section Theorem`,
+		`section given`,
+		`binder x`,
+		`binder y`,
+		`section then`,
+		`variable x`,
+		`operator +`,
+		`command \function:on`,
+		`variable x`,
+		`command :to`,
+		`name z`,
+		`unresolvedCommand \unknown`,
+		`variable y`,
+		`section Id`,
+		`text "2"`,
+	}, actual)
+}
+
+func TestSemanticTokensNamedGroupNamesAreNotBound(t *testing.T) {
+	actual := getSemanticTokenTexts(t, `[\function:on{A}:to{B}]
+Describes: f
+when: 'A = on'
+Id: "1"
+`)
+	assert.Contains(t, actual, "variable A")
+	assert.Contains(t, actual, "name on")
+}
+
+func TestSemanticTokensUnicodeSymbols(t *testing.T) {
+	actual := getSemanticTokenTexts(t, `Theorem:
+given: α, X
+then: 'α = X'
+Id: "1"
+`)
+	assert.Equal(t, []string{
+		`section Theorem`,
+		`section given`,
+		`binder α`,
+		`binder X`,
+		`section then`,
+		`variable α`,
+		`operator =`,
+		`variable X`,
+		`section Id`,
+		`text "1"`,
+	}, actual)
+}
+
+func TestSemanticTokensInfixCommands(t *testing.T) {
+	actual := getSemanticTokenTexts(t, `Theorem:
+given: x, S
+then:
+. 'x \.in:set./ S'
+. 'x ∈ S'
+Id: "1"
+`)
+	assert.Equal(t, []string{
+		`section Theorem`,
+		`section given`,
+		`binder x`,
+		`binder S`,
+		`section then`,
+		`variable x`,
+		`unresolvedCommand \.in:set./`,
+		`variable S`,
+		`variable x`,
+		`unresolvedCommand ∈`,
+		`variable S`,
+		`section Id`,
+		`text "1"`,
+	}, actual)
+}
+
+func TestSemanticTokensUnknownPath(t *testing.T) {
+	workspace := newTestWorkspace(semanticTokensInput)
+	_, err := workspace.SemanticTokens(ast.ToPath("other.math"))
+	assert.NotNil(t, err)
+}
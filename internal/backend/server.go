@@ -26,13 +26,14 @@ import (
 	"mathlingua/web"
 	"net/http"
 	"path"
+	"strconv"
 	"strings"
 
 	"github.com/gorilla/mux"
 )
 
 func StartServer(port int, conf config.MlgConfig) {
-	workspace := initWorkspace()
+	workspace := initWorkspace(conf)
 
 	router := mux.NewRouter()
 	router.Use(handleCors)
@@ -49,6 +50,25 @@ func StartServer(port int, conf config.MlgConfig) {
 		func(w http.ResponseWriter, r *http.Request) {
 			entryBySignature(workspace, w, r)
 		}).Methods("GET")
+	router.HandleFunc("/api/find", func(w http.ResponseWriter, r *http.Request) {
+		find(workspace, w, r)
+	}).Methods("GET")
+	router.HandleFunc("/api/hierarchy/signature/{signature}",
+		func(w http.ResponseWriter, r *http.Request) {
+			hierarchyBySignature(workspace, w, r)
+		}).Methods("GET")
+	router.HandleFunc("/api/hover", func(w http.ResponseWriter, r *http.Request) {
+		hover(workspace, w, r)
+	}).Methods("GET")
+	router.HandleFunc("/api/definition", func(w http.ResponseWriter, r *http.Request) {
+		definition(workspace, w, r)
+	}).Methods("GET")
+	router.HandleFunc("/api/topics", func(w http.ResponseWriter, r *http.Request) {
+		topics(workspace, w, r)
+	}).Methods("GET")
+	router.HandleFunc("/api/tokens", func(w http.ResponseWriter, r *http.Request) {
+		semanticTokens(workspace, w, r)
+	}).Methods("GET")
 	router.PathPrefix("/").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// if the URL path cannot be determined, or corresponds to a static asset,
 		// then let the default handler handle the request
@@ -59,7 +79,7 @@ func StartServer(port int, conf config.MlgConfig) {
 			return
 		}
 
-		workspace = initWorkspace()
+		workspace = initWorkspace(conf)
 
 		// otherwise fall back to responding with the contents of index.html.
 		// This is needed to support client-side route handling.  Also, the
@@ -107,12 +127,12 @@ func StartServer(port int, conf config.MlgConfig) {
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func initWorkspace() *Workspace {
+func initWorkspace(conf config.MlgConfig) *Workspace {
 	tracker := frontend.NewDiagnosticTracker()
 	tracker.AddListener(func(diag frontend.Diagnostic) {
 		fmt.Println(diag.String())
 	})
-	workspace, diagnostics := NewWorkspaceFromPaths([]string{"."}, tracker)
+	workspace, diagnostics := NewWorkspaceFromPaths([]string{"."}, tracker, GetUnicodeTable(conf))
 	for _, diag := range diagnostics {
 		tracker.Append(diag)
 	}
@@ -130,11 +150,12 @@ func paths(workspace *Workspace, writer http.ResponseWriter, request *http.Reque
 func page(workspace *Workspace, writer http.ResponseWriter, request *http.Request) {
 	setJsonContentKind(writer)
 	path := request.URL.Query().Get("path")
-	doc, _, diagnostics := workspace.GetDocumentAt(ast.ToPath(path))
+	doc, diagnostics := workspace.GetViewableDocumentAt(ast.ToPath(path))
 
 	resp := PageResponse{
 		Diagnostics: diagnostics,
 		Document:    doc,
+		Notes:       workspace.GetNotesOnDocument(doc),
 	}
 
 	writeResponse(writer, &resp)
@@ -158,11 +179,16 @@ func entryById(workspace *Workspace, writer http.ResponseWriter, request *http.R
 	errStr := ""
 	if err != nil {
 		errStr = err.Error()
+	} else if !workspace.IsViewableEntry(id) {
+		errStr = fmt.Sprintf("The entry with id %s is not viewable", id)
+		entry = nil
 	}
 
+	fingerprint, _ := workspace.GetFingerprintById(id)
 	resp := EntryResponse{
-		Error: errStr,
-		Entry: entry,
+		Error:       errStr,
+		Entry:       entry,
+		Fingerprint: fingerprint,
 	}
 
 	writeResponse(writer, &resp)
@@ -186,6 +212,9 @@ func entryBySignature(workspace *Workspace, writer http.ResponseWriter, request
 	errStr := ""
 	if err != nil {
 		errStr = err.Error()
+	} else if !workspace.IsViewableSignature(signature) {
+		errStr = fmt.Sprintf("The entry with signature %s is not viewable", signature)
+		entry = nil
 	}
 
 	resp := EntryResponse{
@@ -196,8 +225,215 @@ func entryBySignature(workspace *Workspace, writer http.ResponseWriter, request
 	writeResponse(writer, &resp)
 }
 
+func hierarchyBySignature(workspace *Workspace, writer http.ResponseWriter, request *http.Request) {
+	setJsonContentKind(writer)
+
+	signature, ok := mux.Vars(request)["signature"]
+	if !ok {
+		resp := HierarchyResponse{
+			Error:     "signature not specified",
+			Hierarchy: nil,
+		}
+		writeResponse(writer, &resp)
+		return
+	}
+
+	hierarchy, err := workspace.GetHierarchy(signature)
+
+	resp := HierarchyResponse{
+		Error:     "",
+		Hierarchy: &hierarchy,
+	}
+	if err != nil {
+		resp.Error = err.Error()
+		resp.Hierarchy = nil
+	} else if !workspace.IsViewableSignature(hierarchy.Signature) {
+		resp.Error = fmt.Sprintf("The entry with signature %s is not viewable", signature)
+		resp.Hierarchy = nil
+	} else {
+		hierarchy.Parents = getViewableSignatures(workspace, hierarchy.Parents)
+		hierarchy.Ancestors = getViewableSignatures(workspace, hierarchy.Ancestors)
+		hierarchy.Children = getViewableSignatures(workspace, hierarchy.Children)
+		hierarchy.SpecifiedBy = getViewableSignatures(workspace, hierarchy.SpecifiedBy)
+	}
+
+	writeResponse(writer, &resp)
+}
+
+func find(workspace *Workspace, writer http.ResponseWriter, request *http.Request) {
+	setJsonContentKind(writer)
+
+	query := request.URL.Query().Get("query")
+	if query == "" {
+		resp := FindResponse{
+			Error:   "query not specified",
+			Results: []FindResult{},
+		}
+		writeResponse(writer, &resp)
+		return
+	}
+
+	results, err := workspace.Find(query)
+
+	errStr := ""
+	if err != nil {
+		errStr = err.Error()
+		results = []FindResult{}
+	}
+
+	viewable := make([]FindResult, 0, len(results))
+	for _, result := range results {
+		if workspace.IsViewableEntry(result.Id) {
+			viewable = append(viewable, result)
+		}
+	}
+
+	resp := FindResponse{
+		Error:   errStr,
+		Results: viewable,
+	}
+
+	writeResponse(writer, &resp)
+}
+
+func hover(workspace *Workspace, writer http.ResponseWriter, request *http.Request) {
+	setJsonContentKind(writer)
+
+	path, position, err := getPathAndPosition(request)
+	if err != nil {
+		resp := HoverResponse{
+			Error: err.Error(),
+			Hover: nil,
+		}
+		writeResponse(writer, &resp)
+		return
+	}
+
+	result, err := workspace.Hover(path, position)
+
+	resp := HoverResponse{
+		Error: "",
+		Hover: &result,
+	}
+	if err != nil {
+		resp.Error = err.Error()
+		resp.Hover = nil
+	} else if !workspace.IsViewableSignature(result.Signature) {
+		resp.Error = fmt.Sprintf("The entry with signature %s is not viewable", result.Signature)
+		resp.Hover = nil
+	}
+
+	writeResponse(writer, &resp)
+}
+
+func definition(workspace *Workspace, writer http.ResponseWriter, request *http.Request) {
+	setJsonContentKind(writer)
+
+	path, position, err := getPathAndPosition(request)
+	if err != nil {
+		resp := DefinitionResponse{
+			Error:      err.Error(),
+			Definition: nil,
+		}
+		writeResponse(writer, &resp)
+		return
+	}
+
+	result, err := workspace.Definition(path, position)
+
+	resp := DefinitionResponse{
+		Error:      "",
+		Definition: &result,
+	}
+	if err != nil {
+		resp.Error = err.Error()
+		resp.Definition = nil
+	} else if !workspace.IsViewableSignature(result.Signature) {
+		resp.Error = fmt.Sprintf("The entry with signature %s is not viewable", result.Signature)
+		resp.Definition = nil
+	}
+
+	writeResponse(writer, &resp)
+}
+
+func topics(workspace *Workspace, writer http.ResponseWriter, request *http.Request) {
+	setJsonContentKind(writer)
+	topics := workspace.GetTopics()
+	for i := range topics {
+		members := make([]TopicMember, 0, len(topics[i].Members))
+		for _, member := range topics[i].Members {
+			// members that are not defined are listed without a link
+			if member.Id == "" || workspace.IsViewableEntry(member.Id) {
+				members = append(members, member)
+			}
+		}
+		topics[i].Members = members
+	}
+	resp := TopicsResponse{
+		Error:  "",
+		Topics: topics,
+	}
+	writeResponse(writer, &resp)
+}
+
+func semanticTokens(workspace *Workspace, writer http.ResponseWriter, request *http.Request) {
+	setJsonContentKind(writer)
+
+	path := request.URL.Query().Get("path")
+	if path == "" {
+		resp := SemanticTokensResponse{
+			Error:  "path not specified",
+			Tokens: nil,
+		}
+		writeResponse(writer, &resp)
+		return
+	}
+
+	tokens, err := workspace.SemanticTokens(ast.ToPath(path))
+	resp := SemanticTokensResponse{
+		Error:  "",
+		Tokens: tokens,
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	writeResponse(writer, &resp)
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// getPathAndPosition returns the path and the zero based position specified by the `path`,
+// `row`, and `column` query parameters of the request.
+func getPathAndPosition(request *http.Request) (ast.Path, ast.Position, error) {
+	query := request.URL.Query()
+	path := query.Get("path")
+	if path == "" {
+		return ast.ToPath(""), ast.Position{}, fmt.Errorf("path not specified")
+	}
+	row, err := strconv.Atoi(query.Get("row"))
+	if err != nil {
+		return ast.ToPath(""), ast.Position{}, fmt.Errorf("invalid row: %s", query.Get("row"))
+	}
+	column, err := strconv.Atoi(query.Get("column"))
+	if err != nil {
+		return ast.ToPath(""), ast.Position{}, fmt.Errorf("invalid column: %s",
+			query.Get("column"))
+	}
+	return ast.ToPath(path), ast.Position{Row: row, Column: column}, nil
+}
+
+// getViewableSignatures returns the signatures of the entries that are viewable, so that
+// the entries with a `Viewable: "false"` section are not shown.
+func getViewableSignatures(workspace *Workspace, signatures []string) []string {
+	result := make([]string, 0, len(signatures))
+	for _, signature := range signatures {
+		if workspace.IsViewableSignature(signature) {
+			result = append(result, signature)
+		}
+	}
+	return result
+}
+
 func setJsonContentKind(writer http.ResponseWriter) {
 	writer.Header().Set("Content-Type", "application/json")
 }
@@ -0,0 +1,125 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+const viewableInput = `
+[\set]
+Describes: X
+------------------------------------------
+Id: "1"
+
+
+[\draft.set]
+Describes: X
+extends: 'X is \set'
+Viewable: "false"
+------------------------------------------
+Id: "2"
+
+
+Theorem:
+given: a, b
+then: 'a + b = b + a'
+------------------------------------------
+Id: "3"
+
+
+Theorem:
+given: c, d
+then: 'c + d = d + c'
+Viewable: "false"
+------------------------------------------
+Id: "4"
+`
+
+func TestFindOmitsNotViewableEntries(t *testing.T) {
+	workspace := newTestWorkspace(viewableInput)
+
+	recorder := httptest.NewRecorder()
+	find(workspace, recorder, httptest.NewRequest("GET",
+		"/api/find?query="+url.QueryEscape("x? + y? = y? + x?"), nil))
+
+	var resp FindResponse
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	assert.Equal(t, "", resp.Error)
+	ids := make([]string, 0)
+	for _, result := range resp.Results {
+		ids = append(ids, result.Id)
+	}
+	assert.Equal(t, []string{"3"}, ids)
+}
+
+func TestHierarchyOmitsNotViewableEntries(t *testing.T) {
+	workspace := newTestWorkspace(viewableInput)
+
+	recorder := httptest.NewRecorder()
+	hierarchyBySignature(workspace, recorder, mux.SetURLVars(
+		httptest.NewRequest("GET", "/api/hierarchy/signature/set", nil),
+		map[string]string{"signature": "\\:set"}))
+
+	var resp HierarchyResponse
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	assert.Equal(t, "", resp.Error)
+	assert.Equal(t, []string{}, resp.Hierarchy.Children)
+
+	recorder = httptest.NewRecorder()
+	hierarchyBySignature(workspace, recorder, mux.SetURLVars(
+		httptest.NewRequest("GET", "/api/hierarchy/signature/draft.set", nil),
+		map[string]string{"signature": "\\:draft.set"}))
+
+	resp = HierarchyResponse{}
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	assert.Nil(t, resp.Hierarchy)
+	assert.NotEqual(t, "", resp.Error)
+}
+
+func TestTopicsOmitNotViewableMembers(t *testing.T) {
+	workspace := newTestWorkspace(viewableInput + `
+
+[sets]
+Topic: "Sets"
+content: "The study of sets."
+members:
+. '\:set'
+. '\:draft.set'
+. '\:unknown.set'
+------------------------------------------
+Id: "5"
+`)
+
+	recorder := httptest.NewRecorder()
+	topics(workspace, recorder, httptest.NewRequest("GET", "/api/topics", nil))
+
+	var resp TopicsResponse
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	assert.Equal(t, "", resp.Error)
+	assert.Equal(t, 1, len(resp.Topics))
+	assert.Equal(t, []TopicMember{
+		{Signature: "\\:set", Id: "1"},
+		{Signature: "\\:unknown.set", Id: ""},
+	}, resp.Topics[0].Members)
+}
@@ -153,22 +153,26 @@ func findUsedUnknownSignaturesImpl(node ast.MlgNodeKind, path ast.Path, w *Signa
 		sig := GetSignatureStringFromCommand(*cmd)
 		if _, ok := w.nodeTracker.signaturesToIds[sig]; !ok {
 			w.diasnosticTracker.Append(frontend.Diagnostic{
-				Type:     frontend.Error,
-				Origin:   frontend.BackendOrigin,
-				Message:  fmt.Sprintf("Unrecognized signature %s", sig),
-				Path:     path,
-				Position: node.GetCommonMetaData().Start,
+				Type:        frontend.Error,
+				Origin:      frontend.BackendOrigin,
+				Code:        frontend.UnrecognizedSignatureCode,
+				Message:     fmt.Sprintf("Unrecognized signature %s", sig),
+				Path:        path,
+				Position:    node.GetCommonMetaData().Start,
+				Suggestions: w.GetSuggestions(sig),
 			})
 		}
 	} else if cmd, ok := node.(*ast.InfixCommandExpression); ok {
 		sig := GetSignatureStringFromInfixCommand(*cmd)
 		if _, ok := w.nodeTracker.signaturesToIds[sig]; !ok {
 			w.diasnosticTracker.Append(frontend.Diagnostic{
-				Type:     frontend.Error,
-				Origin:   frontend.BackendOrigin,
-				Message:  fmt.Sprintf("Unrecognized signature %s", sig),
-				Path:     path,
-				Position: node.GetCommonMetaData().Start,
+				Type:        frontend.Error,
+				Origin:      frontend.BackendOrigin,
+				Code:        frontend.UnrecognizedSignatureCode,
+				Message:     fmt.Sprintf("Unrecognized signature %s", sig),
+				Path:        path,
+				Position:    node.GetCommonMetaData().Start,
+				Suggestions: w.GetSuggestions(sig),
 			})
 		}
 	}
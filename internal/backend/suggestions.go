@@ -0,0 +1,135 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"mathlingua/internal/mlglib"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const maxSignatureSuggestions = 3
+
+// the minimum length of each segment of a signature for the signature to be matched against
+// the Documented:called: text of other signatures, since short segments such as `m` in `\m`
+// say little about what the signature is about
+const minCalledSegmentLength = 3
+
+// GetSuggestions returns the known signatures most similar to the given (possibly unknown)
+// signature, most similar first.  Signatures are compared using the edit distance of their
+// segments, and a signature whose Documented:called: text contains every segment of the
+// given signature as a word is also suggested if the edit distance is at most the length
+// of the given signature.
+func (sm *SignatureManager) GetSuggestions(signature string) []string {
+	segments := getSignatureSegments(signature)
+	threshold := max(1, len(strings.Join(segments, ""))/3)
+
+	type candidate struct {
+		signature   string
+		distance    int
+		calledMatch bool
+	}
+
+	candidates := make([]candidate, 0)
+	for sig, id := range sm.nodeTracker.signaturesToIds {
+		if sig == signature {
+			continue
+		}
+		distance := mlglib.SliceEditDistance(segments, getSignatureSegments(sig),
+			func(item string) int {
+				return len(item)
+			}, mlglib.EditDistance)
+		calledMatch := distance <= len(strings.Join(segments, "")) &&
+			sm.calledTextMatches(id, segments)
+		if distance <= threshold || calledMatch {
+			candidates = append(candidates, candidate{
+				signature:   sig,
+				distance:    distance,
+				calledMatch: calledMatch,
+			})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a := candidates[i]
+		b := candidates[j]
+		if a.distance != b.distance {
+			return a.distance < b.distance
+		}
+		if a.calledMatch != b.calledMatch {
+			return a.calledMatch
+		}
+		return a.signature < b.signature
+	})
+
+	result := make([]string, 0)
+	for i := 0; i < len(candidates) && i < maxSignatureSuggestions; i++ {
+		result = append(result, candidates[i].signature)
+	}
+	return result
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// \:set.intersection:of:/ has segments set, intersection, and of
+func getSignatureSegments(signature string) []string {
+	text := strings.TrimPrefix(signature, "\\:")
+	if index := strings.Index(text, "::("); index >= 0 {
+		text = text[:index]
+	}
+	text = strings.TrimSuffix(text, ":/")
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return r == '.' || r == ':'
+	})
+}
+
+func (sm *SignatureManager) calledTextMatches(id string, segments []string) bool {
+	if len(segments) == 0 {
+		return false
+	}
+	for _, seg := range segments {
+		if len(seg) < minCalledSegmentLength {
+			return false
+		}
+	}
+	entry, ok := sm.nodeTracker.topLevelEntries[id]
+	if !ok {
+		return false
+	}
+	summary, ok := GetDocumentedSummary(entry, sm.diasnosticTracker)
+	if !ok || summary == nil {
+		return false
+	}
+	for _, called := range summary.Called {
+		words := strings.FieldsFunc(strings.ToLower(called.RawCalled), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		matches := true
+		for _, seg := range segments {
+			if !slices.Contains(words, strings.ToLower(seg)) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,225 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"math"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/structural/phase4"
+	"strings"
+)
+
+const (
+	ignoreDirective     = "mlg:ignore"
+	ignoreFileDirective = "mlg:ignore-file"
+)
+
+// SuppressionTracker records the `-- mlg:ignore CODE reason` and `-- mlg:ignore-file CODE`
+// comments in a workspace and is used to remove the diagnostics they suppress.
+type SuppressionTracker struct {
+	// the tracker used to record diagnostics
+	tracker      *frontend.DiagnosticTracker
+	suppressions []suppression
+}
+
+func NewSuppressionTracker(
+	nodeTracker *NodeTracker,
+	tracker *frontend.DiagnosticTracker,
+) *SuppressionTracker {
+	st := SuppressionTracker{
+		tracker:      tracker,
+		suppressions: make([]suppression, 0),
+	}
+	st.initialize(nodeTracker)
+	return &st
+}
+
+// Filter returns the diagnostics that are not suppressed, and records which suppressions
+// were used.
+func (st *SuppressionTracker) Filter(diagnostics []frontend.Diagnostic) []frontend.Diagnostic {
+	result := make([]frontend.Diagnostic, 0)
+	for _, diag := range diagnostics {
+		suppressed := false
+		for i := range st.suppressions {
+			if st.suppressions[i].suppresses(diag) {
+				st.suppressions[i].used = true
+				suppressed = true
+			}
+		}
+		if !suppressed {
+			result = append(result, diag)
+		}
+	}
+	return result
+}
+
+// GetUnusedSuppressions returns a warning for each suppression that has not suppressed
+// any diagnostic passed to Filter.
+func (st *SuppressionTracker) GetUnusedSuppressions() []frontend.Diagnostic {
+	result := make([]frontend.Diagnostic, 0)
+	for _, s := range st.suppressions {
+		if !s.used {
+			result = append(result, frontend.Diagnostic{
+				Type:     frontend.Warning,
+				Origin:   frontend.BackendOrigin,
+				Code:     frontend.UnusedSuppressionCode,
+				Message:  fmt.Sprintf("Unused suppression of %s", s.code),
+				Path:     s.path,
+				Position: s.position,
+			})
+		}
+	}
+	return result
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type suppression struct {
+	path     ast.Path
+	code     frontend.DiagnosticCode
+	position ast.Position
+	// the rows the suppression applies to (inclusive) or
+	// -1 for both if it applies to the entire file
+	startRow int
+	endRow   int
+	used     bool
+}
+
+func (s *suppression) suppresses(diag frontend.Diagnostic) bool {
+	if diag.Path != s.path || diag.Code != s.code {
+		return false
+	}
+	if s.startRow == -1 && s.endRow == -1 {
+		return true
+	}
+	return diag.Position.Row >= s.startRow && diag.Position.Row <= s.endRow
+}
+
+// the rows (inclusive) spanned by a group or section
+type rowRange struct {
+	start int
+	end   int
+}
+
+func (st *SuppressionTracker) initialize(nodeTracker *NodeTracker) {
+	for path, doc := range nodeTracker.phase4Root.Documents {
+		ranges := getDocumentRowRanges(doc)
+		for _, comment := range doc.Comments {
+			st.processComment(path, comment, ranges)
+		}
+	}
+}
+
+func (st *SuppressionTracker) processComment(path ast.Path, comment ast.Token, ranges []rowRange) {
+	fields := strings.Fields(strings.TrimPrefix(comment.Text, "--"))
+	if len(fields) == 0 || (fields[0] != ignoreDirective && fields[0] != ignoreFileDirective) {
+		return
+	}
+
+	if len(fields) < 2 {
+		st.appendWarning(path, comment.Position,
+			fmt.Sprintf("Expected a diagnostic code after %s", fields[0]))
+		return
+	}
+
+	s := suppression{
+		path:     path,
+		code:     frontend.DiagnosticCode(fields[1]),
+		position: comment.Position,
+		startRow: -1,
+		endRow:   -1,
+	}
+
+	if fields[0] == ignoreDirective {
+		found := false
+		for _, r := range ranges {
+			// the ranges are in document order and so the first one found
+			// is the outermost group or section following the comment
+			if r.start > comment.Position.Row {
+				s.startRow = r.start
+				s.endRow = r.end
+				found = true
+				break
+			}
+		}
+		if !found {
+			st.appendWarning(path, comment.Position,
+				fmt.Sprintf("A %s comment must be followed by a group or section", ignoreDirective))
+			return
+		}
+	}
+
+	st.suppressions = append(st.suppressions, s)
+}
+
+func (st *SuppressionTracker) appendWarning(path ast.Path, position ast.Position, message string) {
+	st.tracker.Append(frontend.Diagnostic{
+		Type:     frontend.Warning,
+		Origin:   frontend.BackendOrigin,
+		Code:     frontend.InvalidSuppressionCode,
+		Message:  message,
+		Path:     path,
+		Position: position,
+	})
+}
+
+func getDocumentRowRanges(doc phase4.Document) []rowRange {
+	result := make([]rowRange, 0)
+	for i, node := range doc.Nodes {
+		end := math.MaxInt
+		if i+1 < len(doc.Nodes) {
+			end = doc.Nodes[i+1].Start().Row - 1
+		}
+		if group, ok := node.(*phase4.Group); ok {
+			appendGroupRowRanges(group, end, &result)
+		} else {
+			result = append(result, rowRange{
+				start: node.Start().Row,
+				end:   end,
+			})
+		}
+	}
+	return result
+}
+
+func appendGroupRowRanges(group *phase4.Group, end int, result *[]rowRange) {
+	*result = append(*result, rowRange{
+		start: group.MetaData.Start.Row,
+		end:   end,
+	})
+	for i, section := range group.Sections {
+		sectionEnd := end
+		if i+1 < len(group.Sections) {
+			sectionEnd = group.Sections[i+1].MetaData.Start.Row - 1
+		}
+		*result = append(*result, rowRange{
+			start: section.MetaData.Start.Row,
+			end:   sectionEnd,
+		})
+		for j, arg := range section.Args {
+			if subGroup, ok := arg.Arg.(*phase4.Group); ok {
+				argEnd := sectionEnd
+				if j+1 < len(section.Args) {
+					argEnd = section.Args[j+1].MetaData.Start.Row - 1
+				}
+				appendGroupRowRanges(subGroup, argEnd, result)
+			}
+		}
+	}
+}
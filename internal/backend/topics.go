@@ -0,0 +1,112 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+)
+
+type Topic struct {
+	Id      string
+	Name    string
+	Content string
+	Path    ast.Path
+	Members []TopicMember
+}
+
+type TopicMember struct {
+	Signature string
+	// the id of the entry with the signature or the empty string if the
+	// signature is not defined in the workspace
+	Id string
+}
+
+// GetTopics returns the viewable `Topic:` entries in the workspace ordered by path and
+// then by the position of the entry in its file.
+func (w *Workspace) GetTopics() []Topic {
+	result := make([]Topic, 0)
+	for _, path := range getSortedPaths(w.nodeTracker.astRoot.Documents) {
+		for _, item := range w.nodeTracker.astRoot.Documents[path].Items {
+			topic, ok := item.(*ast.TopicGroup)
+			if !ok || !IsViewable(topic) {
+				continue
+			}
+			id, _ := GetAstMetaId(topic)
+			members := make([]TopicMember, 0, len(topic.Members.Members))
+			for _, sig := range getTopicMemberSignatures(topic) {
+				memberId, _ := w.nodeTracker.GetIdForSignature(sig)
+				members = append(members, TopicMember{
+					Signature: sig,
+					Id:        memberId,
+				})
+			}
+			result = append(result, Topic{
+				Id:      id,
+				Name:    topic.Topic.Name.RawText,
+				Content: topic.Content.Content.RawText,
+				Path:    path,
+				Members: members,
+			})
+		}
+	}
+	return result
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// findUnknownTopicMembers reports an error for each signature in the `members:` section
+// of a `Topic:` entry that is not the signature of an entry in the workspace.
+func (sm *SignatureManager) findUnknownTopicMembers() {
+	for path, doc := range sm.nodeTracker.astRoot.Documents {
+		for _, item := range doc.Items {
+			topic, ok := item.(*ast.TopicGroup)
+			if !ok {
+				continue
+			}
+			for _, member := range topic.Members.Members {
+				sig, ok := member.Root.(*ast.Signature)
+				if !ok {
+					continue
+				}
+				sigText := sig.ToCode(ast.NoOp)
+				if _, ok := sm.nodeTracker.signaturesToIds[sigText]; !ok {
+					sm.diasnosticTracker.Append(frontend.Diagnostic{
+						Type:        frontend.Error,
+						Origin:      frontend.BackendOrigin,
+						Code:        frontend.UnrecognizedSignatureCode,
+						Message:     fmt.Sprintf("Unrecognized topic member %s", sigText),
+						Path:        path,
+						Position:    member.CommonMetaData.Start,
+						Suggestions: sm.GetSuggestions(sigText),
+					})
+				}
+			}
+		}
+	}
+}
+
+func getTopicMemberSignatures(topic *ast.TopicGroup) []string {
+	result := make([]string, 0, len(topic.Members.Members))
+	for _, member := range topic.Members.Members {
+		if sig, ok := member.Root.(*ast.Signature); ok {
+			result = append(result, sig.ToCode(ast.NoOp))
+		}
+	}
+	return result
+}
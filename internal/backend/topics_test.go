@@ -0,0 +1,56 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"mathlingua/internal/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTopics(t *testing.T) {
+	workspace := newTestWorkspace(`
+[\group]
+Describes: G
+------------------------------------------
+Id: "1"
+
+
+[group.theory]
+Topic: "Group theory"
+content: "The study of groups."
+members:
+. '\:group'
+. '\:ring'
+------------------------------------------
+Id: "2"
+`)
+
+	assert.Equal(t, []Topic{
+		{
+			Id:      "2",
+			Name:    "Group theory",
+			Content: "The study of groups.",
+			Path:    ast.ToPath("test.math"),
+			Members: []TopicMember{
+				{Signature: "\\:group", Id: "1"},
+				{Signature: "\\:ring", Id: ""},
+			},
+		},
+	}, workspace.GetTopics())
+}
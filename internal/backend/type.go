@@ -0,0 +1,150 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+)
+
+func GetTypeSummary(
+	node ast.TopLevelItemKind,
+	tracker *frontend.DiagnosticTracker,
+) (*ast.TypeSummary, bool) {
+	switch entry := node.(type) {
+	case *ast.DescribesGroup:
+		return GetDescribesTypeSummary(entry), true
+	case *ast.DefinesGroup:
+		return GetDefinesTypeSummary(entry), true
+	default:
+		return nil, false
+	}
+}
+
+func GetDescribesTypeSummary(describes *ast.DescribesGroup) *ast.TypeSummary {
+	if describes == nil {
+		return nil
+	}
+	is := make([]string, 0)
+	if name, ok := getTargetName(describes.Describes.Describes); ok && describes.Extends != nil {
+		is = getIsSignatures(name, describes.Extends.Extends)
+	}
+	return &ast.TypeSummary{
+		Is:        is,
+		Operators: getProvidedOperatorSummaries(describes.Provides),
+	}
+}
+
+func GetDefinesTypeSummary(defines *ast.DefinesGroup) *ast.TypeSummary {
+	if defines == nil {
+		return nil
+	}
+	is := make([]string, 0)
+	if name, ok := getTargetName(defines.Defines.Defines); ok && defines.Means != nil {
+		is = getIsSignatures(name, defines.Means.Means)
+	}
+	return &ast.TypeSummary{
+		Is:        is,
+		Operators: getProvidedOperatorSummaries(defines.Provides),
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// getTargetName returns the name of the item introduced by a target, for example
+// `X` for `X`, `X := (a, b)`, and `f(x) := y`.
+func getTargetName(target ast.Target) (string, bool) {
+	switch root := target.Root.(type) {
+	case *ast.NameForm:
+		return root.Text, true
+	case *ast.StructuralColonEqualsForm:
+		if name, ok := root.Lhs.(*ast.NameForm); ok {
+			return name.Text, true
+		}
+		if name, ok := root.Rhs.(*ast.NameForm); ok {
+			return name.Text, true
+		}
+		return "", false
+	default:
+		return "", false
+	}
+}
+
+// getIsSignatures returns the signatures of the types `name` is described to
+// be by statements of the form `name is \type` in the given clauses.
+func getIsSignatures(name string, clauses []ast.ClauseKind) []string {
+	result := make([]string, 0)
+	for _, clause := range clauses {
+		formulation, ok := clause.(*ast.Formulation[ast.FormulationNodeKind])
+		if !ok {
+			continue
+		}
+		is, ok := formulation.Root.(*ast.IsExpression)
+		if !ok {
+			continue
+		}
+		for _, lhs := range is.Lhs {
+			if lhsName, ok := lhs.(*ast.NameForm); ok && lhsName.Text == name {
+				result = append(result, getKindSignatures(is.Rhs)...)
+			}
+		}
+	}
+	return result
+}
+
+func getKindSignatures(kinds []ast.KindKind) []string {
+	result := make([]string, 0)
+	for _, kind := range kinds {
+		if cmd, ok := kind.(*ast.CommandExpression); ok {
+			result = append(result, GetSignatureStringFromCommand(*cmd))
+		}
+	}
+	return result
+}
+
+func getProvidedOperatorSummaries(provides *ast.ProvidesSection) []ast.ProvidedOperatorSummary {
+	result := make([]ast.ProvidedOperatorSummary, 0)
+	if provides == nil {
+		return result
+	}
+	for _, item := range provides.Provides {
+		switch n := item.(type) {
+		case *ast.Alias:
+			result = append(result, ast.ProvidedOperatorSummary{
+				Symbol:  *n,
+				Written: make([]ast.WrittenSummary, 0),
+			})
+		case *ast.SymbolWrittenGroup:
+			written := make([]ast.WrittenSummary, 0)
+			if n.Written != nil {
+				for _, item := range n.Written.Written {
+					parsed, err := ParseCalledWritten(item.RawText)
+					written = append(written, ast.WrittenSummary{
+						RawWritten:    item.RawText,
+						ParsedWritten: parsed,
+						Errors:        errorToString(err),
+					})
+				}
+			}
+			result = append(result, ast.ProvidedOperatorSummary{
+				Symbol:  n.Symbol.Symbol,
+				Written: written,
+			})
+		}
+	}
+	return result
+}
@@ -0,0 +1,507 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/formulation"
+	"mathlingua/internal/mlglib"
+	"slices"
+)
+
+// Unfold returns the Mathlingua code of the entry with the given id or signature where each
+// statement `x is \c` is replaced with the `means:` clauses of the `Defines:` entry for `\c`,
+// or the `satisfies:` clauses of the `Describes:` entry for `\c`, with the inputs of `\c`
+// replaced by the arguments used in the statement.  The replacement is repeated for the
+// statements in the replaced clauses up to the given depth, where a negative depth means
+// to unfold until no statements can be unfolded.  The `Id:` of the entry is not included
+// since the result is a different entry.
+func (w *Workspace) Unfold(idOrSignature string, depth int) (string, error) {
+	item, err := w.getUnfoldEntry(idOrSignature)
+	if err != nil {
+		return "", err
+	}
+
+	u := unfolder{
+		workspace: w,
+		unfolding: make(map[string]bool),
+		keyGen:    mlglib.NewKeyGenerator(),
+	}
+	env := make(map[string]ast.MlgNodeKind)
+	var result ast.StructuralNodeKind
+	switch n := item.(type) {
+	case *ast.TheoremGroup:
+		entry := *n
+		entry.MetaId = nil
+		entry.SuchThat = u.suchThat(n.SuchThat, env, depth)
+		entry.If = u.ifSection(n.If, env, depth)
+		entry.Iff = u.iffSection(n.Iff, env, depth)
+		entry.Then.Clauses = u.clauses(n.Then.Clauses, env, depth)
+		result = &entry
+	case *ast.LemmaGroup:
+		entry := *n
+		entry.MetaId = nil
+		entry.SuchThat = u.suchThat(n.SuchThat, env, depth)
+		entry.If = u.ifSection(n.If, env, depth)
+		entry.Iff = u.iffSection(n.Iff, env, depth)
+		entry.Then.Clauses = u.clauses(n.Then.Clauses, env, depth)
+		result = &entry
+	case *ast.CorollaryGroup:
+		entry := *n
+		entry.MetaId = nil
+		entry.SuchThat = u.suchThat(n.SuchThat, env, depth)
+		entry.If = u.ifSection(n.If, env, depth)
+		entry.Iff = u.iffSection(n.Iff, env, depth)
+		entry.Then.Clauses = u.clauses(n.Then.Clauses, env, depth)
+		result = &entry
+	case *ast.AxiomGroup:
+		entry := *n
+		entry.MetaId = nil
+		entry.SuchThat = u.suchThat(n.SuchThat, env, depth)
+		entry.If = u.ifSection(n.If, env, depth)
+		entry.Iff = u.iffSection(n.Iff, env, depth)
+		entry.Then.Clauses = u.clauses(n.Then.Clauses, env, depth)
+		result = &entry
+	case *ast.ConjectureGroup:
+		entry := *n
+		entry.MetaId = nil
+		entry.SuchThat = u.suchThat(n.SuchThat, env, depth)
+		entry.If = u.ifSection(n.If, env, depth)
+		entry.Iff = u.iffSection(n.Iff, env, depth)
+		entry.Then.Clauses = u.clauses(n.Then.Clauses, env, depth)
+		result = &entry
+	case *ast.DefinesGroup:
+		entry := *n
+		entry.MetaId = nil
+		if sig, ok := GetSignatureStringFromTopLevel(n); ok {
+			u.unfolding[sig] = true
+		}
+		entry.SuchThat = u.suchThat(n.SuchThat, env, depth)
+		if n.Means != nil {
+			means := *n.Means
+			means.Means = u.clauses(n.Means.Means, env, depth)
+			entry.Means = &means
+		}
+		result = &entry
+	case *ast.DescribesGroup:
+		entry := *n
+		entry.MetaId = nil
+		if sig, ok := GetSignatureStringFromTopLevel(n); ok {
+			u.unfolding[sig] = true
+		}
+		entry.SuchThat = u.suchThat(n.SuchThat, env, depth)
+		if n.Satisfies != nil {
+			satisfies := *n.Satisfies
+			satisfies.Satisfies = u.clauses(n.Satisfies.Satisfies, env, depth)
+			entry.Satisfies = &satisfies
+		}
+		result = &entry
+	default:
+		return "", fmt.Errorf("The entry %s cannot be unfolded", idOrSignature)
+	}
+	if u.err != nil {
+		return "", u.err
+	}
+	return ast.StructuralNodeToCode(result), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (w *Workspace) getUnfoldEntry(idOrSignature string) (ast.TopLevelItemKind, error) {
+	if _, item, err := w.nodeTracker.GetEntryById(idOrSignature); err == nil {
+		return item, nil
+	}
+	if _, item, err := w.nodeTracker.GetEntryBySignature(
+		normalizeSignature(idOrSignature)); err == nil {
+		return item, nil
+	}
+	return nil, fmt.Errorf("Could not find an entry with id or signature %s", idOrSignature)
+}
+
+// unfolder creates copies of clauses where the names in an environment are replaced by
+// the nodes they map to and statements are unfolded.  The formulations in the copies are
+// parsed from the code with the replacements so that statements in the replaced code
+// can be matched to the definitions they use.
+type unfolder struct {
+	workspace *Workspace
+	// the signatures of the definitions currently being unfolded, used to stop unfolding
+	// circular definitions
+	unfolding map[string]bool
+	keyGen    *mlglib.KeyGenerator
+	// the first formulation that could not be unfolded, if any
+	err error
+}
+
+func (u *unfolder) clauses(
+	clauses []ast.ClauseKind,
+	env map[string]ast.MlgNodeKind,
+	depth int,
+) []ast.ClauseKind {
+	result := make([]ast.ClauseKind, 0, len(clauses))
+	for _, clause := range clauses {
+		result = append(result, u.clause(clause, env, depth))
+	}
+	return result
+}
+
+func (u *unfolder) clause(
+	clause ast.ClauseKind,
+	env map[string]ast.MlgNodeKind,
+	depth int,
+) ast.ClauseKind {
+	switch c := clause.(type) {
+	case *ast.Formulation[ast.FormulationNodeKind]:
+		return u.formulation(c, env, depth)
+	case *ast.AllOfGroup:
+		group := *c
+		group.AllOf.Clauses = u.clauses(c.AllOf.Clauses, env, depth)
+		return &group
+	case *ast.AnyOfGroup:
+		group := *c
+		group.AnyOf.Clauses = u.clauses(c.AnyOf.Clauses, env, depth)
+		return &group
+	case *ast.OneOfGroup:
+		group := *c
+		group.OneOf.Clauses = u.clauses(c.OneOf.Clauses, env, depth)
+		return &group
+	case *ast.NotGroup:
+		group := *c
+		group.Not.Clause = u.clause(c.Not.Clause, env, depth)
+		return &group
+	case *ast.EquivalentlyGroup:
+		group := *c
+		group.Equivalently.Clauses = u.clauses(c.Equivalently.Clauses, env, depth)
+		return &group
+	case *ast.IfGroup:
+		group := *c
+		group.If.Clauses = u.clauses(c.If.Clauses, env, depth)
+		group.Then.Clauses = u.clauses(c.Then.Clauses, env, depth)
+		return &group
+	case *ast.IffGroup:
+		group := *c
+		group.Iff.Clauses = u.clauses(c.Iff.Clauses, env, depth)
+		group.Then.Clauses = u.clauses(c.Then.Clauses, env, depth)
+		return &group
+	case *ast.ForAllGroup:
+		group := *c
+		targets, inner := bindNames(c, c.ForAll.Targets, env)
+		group.ForAll.Targets = targets
+		group.Where = u.where(c.Where, inner)
+		group.SuchThat = u.suchThat(c.SuchThat, inner, depth)
+		group.Then.Clauses = u.clauses(c.Then.Clauses, inner, depth)
+		return &group
+	case *ast.ExistsGroup:
+		group := *c
+		targets, inner := bindNames(c, c.Exists.Targets, env)
+		group.Exists.Targets = targets
+		group.Where = u.where(c.Where, inner)
+		group.SuchThat = u.suchThat(c.SuchThat, inner, depth)
+		return &group
+	case *ast.ExistsUniqueGroup:
+		group := *c
+		targets, inner := bindNames(c, c.ExistsUnique.Targets, env)
+		group.ExistsUnique.Targets = targets
+		group.Where = u.where(c.Where, inner)
+		group.SuchThat.Clauses = u.clauses(c.SuchThat.Clauses, inner, depth)
+		return &group
+	default:
+		return clause
+	}
+}
+
+func (u *unfolder) suchThat(
+	section *ast.SuchThatSection,
+	env map[string]ast.MlgNodeKind,
+	depth int,
+) *ast.SuchThatSection {
+	if section == nil {
+		return nil
+	}
+	result := *section
+	result.Clauses = u.clauses(section.Clauses, env, depth)
+	return &result
+}
+
+func (u *unfolder) ifSection(
+	section *ast.IfSection,
+	env map[string]ast.MlgNodeKind,
+	depth int,
+) *ast.IfSection {
+	if section == nil {
+		return nil
+	}
+	result := *section
+	result.Clauses = u.clauses(section.Clauses, env, depth)
+	return &result
+}
+
+func (u *unfolder) iffSection(
+	section *ast.IffSection,
+	env map[string]ast.MlgNodeKind,
+	depth int,
+) *ast.IffSection {
+	if section == nil {
+		return nil
+	}
+	result := *section
+	result.Clauses = u.clauses(section.Clauses, env, depth)
+	return &result
+}
+
+func (u *unfolder) where(
+	section *ast.WhereSection,
+	env map[string]ast.MlgNodeKind,
+) *ast.WhereSection {
+	if section == nil {
+		return nil
+	}
+	result := *section
+	result.Specs = make([]ast.Spec, 0, len(section.Specs))
+	for _, spec := range section.Specs {
+		if spec.Root != nil {
+			spec.Root = u.substitute(spec.Root, env)
+		}
+		result.Specs = append(result.Specs, spec)
+	}
+	return &result
+}
+
+// formulation returns the formulation with the names in the environment replaced and, if
+// the formulation is a statement `x is \c` that can be unfolded, returns the unfolded
+// clauses of `\c` instead.
+func (u *unfolder) formulation(
+	node *ast.Formulation[ast.FormulationNodeKind],
+	env map[string]ast.MlgNodeKind,
+	depth int,
+) ast.ClauseKind {
+	if node.Root == nil {
+		return node
+	}
+	root := u.substitute(node.Root, env)
+	if root == nil {
+		return node
+	}
+
+	if is, ok := root.(*ast.IsExpression); ok && depth != 0 {
+		if unfolded, ok := u.unfoldIs(is, depth); ok {
+			return unfolded
+		}
+	}
+
+	result := *node
+	result.Root = root
+	return &result
+}
+
+// unfoldIs returns the clauses of the definitions used in the right-hand-side of the given
+// `is` statement for each of the items on its left-hand-side.
+func (u *unfolder) unfoldIs(is *ast.IsExpression, depth int) (ast.ClauseKind, bool) {
+	result := make([]ast.ClauseKind, 0)
+	for _, lhs := range is.Lhs {
+		for _, rhs := range is.Rhs {
+			cmd, ok := rhs.(*ast.CommandExpression)
+			if !ok {
+				return nil, false
+			}
+			clauses, ok := u.unfoldCommand(lhs, cmd, depth)
+			if !ok {
+				return nil, false
+			}
+			result = append(result, clauses...)
+		}
+	}
+	if len(result) == 1 {
+		return result[0], true
+	}
+	return &ast.AllOfGroup{
+		AllOf: ast.AllOfSection{
+			Clauses: result,
+		},
+	}, true
+}
+
+func (u *unfolder) unfoldCommand(
+	lhs ast.ExpressionKind,
+	cmd *ast.CommandExpression,
+	depth int,
+) ([]ast.ClauseKind, bool) {
+	sig := GetSignatureStringFromCommand(*cmd)
+	if u.unfolding[sig] {
+		return nil, false
+	}
+	_, item, err := u.workspace.nodeTracker.GetEntryBySignature(sig)
+	if err != nil {
+		return nil, false
+	}
+
+	var id ast.IdItem
+	var target ast.Target
+	var body []ast.ClauseKind
+	switch n := item.(type) {
+	case *ast.DefinesGroup:
+		if n.Means == nil {
+			return nil, false
+		}
+		id, target, body = n.Id, n.Defines.Defines, n.Means.Means
+	case *ast.DescribesGroup:
+		if n.Satisfies == nil {
+			return nil, false
+		}
+		id, target, body = n.Id, n.Describes.Describes, n.Satisfies.Satisfies
+	default:
+		return nil, false
+	}
+
+	commandId, ok := id.Root.(*ast.CommandId)
+	if !ok {
+		return nil, false
+	}
+	commandPattern := ToCommandPattern(*commandId)
+	commandMatch := Match(cmd, &commandPattern)
+	targetPattern := ToPatternFromTarget(target)
+	if targetPattern == nil {
+		return nil, false
+	}
+	targetMatch := Match(lhs, targetPattern)
+	env := make(map[string]ast.MlgNodeKind)
+	for _, match := range []MatchResult{commandMatch, targetMatch} {
+		if !match.MatchMakesSense || len(match.Messages) > 0 {
+			return nil, false
+		}
+		for name, value := range match.Mapping {
+			env[name] = value
+		}
+	}
+
+	u.unfolding[sig] = true
+	defer delete(u.unfolding, sig)
+	return u.clauses(body, env, depth-1), true
+}
+
+// substitute returns the node with each name in the environment replaced by the node it
+// maps to, parsed so that the result can be unfolded further.  If the result cannot be
+// parsed, nil is returned and the error is recorded, since the node would otherwise use
+// the names of a definition's inputs where they are not defined.
+func (u *unfolder) substitute(
+	node ast.FormulationNodeKind,
+	env map[string]ast.MlgNodeKind,
+) ast.FormulationNodeKind {
+	code := node.ToCode(func(node ast.MlgNodeKind) (string, bool) {
+		if name, ok := node.(*ast.NameForm); ok && !name.VarArg.IsVarArg {
+			if value, ok := env[name.Text]; ok {
+				return toSubstitutionCode(value), true
+			}
+		}
+		return "", false
+	})
+	tracker := frontend.NewDiagnosticTracker()
+	result, ok := formulation.ParseExpression("", code, ast.Position{}, tracker, u.keyGen,
+		u.workspace.nodeTracker.operators, u.workspace.nodeTracker.unicodeTable)
+	if !ok || len(tracker.Diagnostics()) > 0 {
+		if u.err == nil {
+			u.err = fmt.Errorf("Could not unfold %s since %s could not be parsed",
+				node.ToCode(ast.NoOp), code)
+		}
+		return nil
+	}
+	return result
+}
+
+// toSubstitutionCode returns the code of the node, wrapped in parentheses if the code could
+// be parsed differently when used in place of a name.
+func toSubstitutionCode(node ast.MlgNodeKind) string {
+	code := ast.Debug(node, ast.NoOp)
+	switch node.(type) {
+	case *ast.NameForm, *ast.SymbolForm, *ast.CommandExpression, *ast.FunctionCallExpression,
+		*ast.TupleExpression:
+		return code
+	default:
+		return "(" + code + ")"
+	}
+}
+
+// bindNames returns the targets of the given group, which binds them, with the environment
+// used in the group.  The names bound by the targets are removed from the environment, and
+// a bound name that is used in the code the environment maps to is renamed so that the
+// code does not refer to the bound name instead.  For example, if y maps to x, `exists: x`
+// becomes `exists: x1` so that it does not capture the x y maps to.
+func bindNames(
+	group ast.MlgNodeKind,
+	targets []ast.Target,
+	env map[string]ast.MlgNodeKind,
+) ([]ast.Target, map[string]ast.MlgNodeKind) {
+	bound := make([]string, 0)
+	for _, target := range targets {
+		forEachName(target.Root, func(name *ast.NameForm) {
+			bound = appendUnique(bound, name.Text)
+		})
+	}
+
+	inner := make(map[string]ast.MlgNodeKind)
+	used := make(map[string]bool)
+	for name, value := range env {
+		if !slices.Contains(bound, name) {
+			inner[name] = value
+			forEachName(value, func(name *ast.NameForm) {
+				used[name.Text] = true
+			})
+		}
+	}
+
+	renames := make(map[string]string)
+	for _, name := range bound {
+		if !used[name] {
+			continue
+		}
+		// the new name cannot be a name used in the group or the environment
+		forEachName(group, func(name *ast.NameForm) {
+			used[name.Text] = true
+		})
+		fresh := getFreshName(name, used)
+		used[fresh] = true
+		renames[name] = fresh
+		inner[name] = &ast.NameForm{Text: fresh}
+	}
+	if len(renames) == 0 {
+		return targets, inner
+	}
+
+	result := make([]ast.Target, 0, len(targets))
+	for _, target := range targets {
+		target.Root = ast.Clone(target.Root)
+		forEachName(target.Root, func(name *ast.NameForm) {
+			if fresh, ok := renames[name.Text]; ok {
+				name.Text = fresh
+			}
+		})
+		result = append(result, target)
+	}
+	return result, inner
+}
+
+// getFreshName returns the name followed by the smallest number such that the result
+// is not used.
+func getFreshName(name string, used map[string]bool) string {
+	for i := 1; ; i++ {
+		fresh := fmt.Sprintf("%s%d", name, i)
+		if !used[fresh] {
+			return fresh
+		}
+	}
+}
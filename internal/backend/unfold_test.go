@@ -0,0 +1,170 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const unfoldInput = `
+[\integer]
+Describes: n
+satisfies: 'n is \number'
+------------------------------------------
+Id: "1"
+
+
+[\even]
+Defines: n
+means:
+. 'n is \integer'
+. exists: k
+  suchThat: 'n = 2*k'
+------------------------------------------
+Id: "2"
+
+
+[\divides:by{d}]
+Defines: n
+means:
+. exists: m
+  suchThat: 'n = d*m'
+------------------------------------------
+Id: "3"
+
+
+Theorem:
+given: a, b
+then:
+. 'a + b is \even'
+. forAll: c
+  then: 'c is \divides:by{a}'
+------------------------------------------
+Id: "4"
+
+
+[\loop]
+Defines: x
+means: 'x is \loop'
+------------------------------------------
+Id: "5"
+`
+
+func TestUnfold(t *testing.T) {
+	workspace := newTestWorkspace(unfoldInput)
+
+	actual, err := workspace.Unfold("4", -1)
+	assert.Nil(t, err)
+	assert.Equal(t, `Theorem:
+given:
+. a
+. b
+then:
+. allOf:
+  . '(a + b) is \number'
+  . exists:
+    . k
+    suchThat:
+    . '(a + b) = 2 * k'
+. forAll:
+  . c
+  then:
+  . exists:
+    . m
+    suchThat:
+    . 'c = a * m'`, actual)
+}
+
+func TestUnfoldWithDepth(t *testing.T) {
+	workspace := newTestWorkspace(unfoldInput)
+
+	actual, err := workspace.Unfold("\\even", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, `[\even]
+Defines:
+. n
+means:
+. 'n is \number'
+. exists:
+  . k
+  suchThat:
+  . 'n = 2 * k'`, actual)
+
+	actual, err = workspace.Unfold("\\even", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, `[\even]
+Defines:
+. n
+means:
+. 'n is \integer'
+. exists:
+  . k
+  suchThat:
+  . 'n = 2 * k'`, actual)
+}
+
+func TestUnfoldCircularDefinition(t *testing.T) {
+	workspace := newTestWorkspace(unfoldInput)
+
+	actual, err := workspace.Unfold("\\loop", -1)
+	assert.Nil(t, err)
+	assert.Equal(t, `[\loop]
+Defines:
+. x
+means:
+. 'x is \loop'`, actual)
+}
+
+func TestUnfoldUnknownEntry(t *testing.T) {
+	workspace := newTestWorkspace(unfoldInput)
+
+	_, err := workspace.Unfold("\\odd", -1)
+	assert.NotNil(t, err)
+}
+
+func TestUnfoldRenamesCapturedBoundNames(t *testing.T) {
+	workspace := newTestWorkspace(`
+[\bounded{A}]
+Defines: X
+means:
+. exists: y
+  suchThat: 'X = A + y'
+------------------------------------------
+Id: "1"
+
+
+Theorem:
+given: y, z
+then: 'z is \bounded{y}'
+------------------------------------------
+Id: "2"
+`)
+
+	actual, err := workspace.Unfold("2", -1)
+	assert.Nil(t, err)
+	assert.Equal(t, `Theorem:
+given:
+. y
+. z
+then:
+. exists:
+  . y1
+  suchThat:
+  . 'z = y + y1'`, actual)
+}
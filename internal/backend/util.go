@@ -21,8 +21,77 @@ import (
 	"hash/fnv"
 	"mathlingua/internal/ast"
 	"mathlingua/internal/frontend/structural/phase4"
+	"slices"
+	"sort"
 )
 
+// getSortedPaths returns the paths of the given documents in sorted order so that the
+// documents are processed in the same order each time.
+func getSortedPaths[T any](docs map[ast.Path]T) []ast.Path {
+	paths := make([]ast.Path, 0, len(docs))
+	for path := range docs {
+		paths = append(paths, path)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		return paths[i] < paths[j]
+	})
+	return paths
+}
+
+// appendUnique appends each of the values to the items that is not already in the items.
+func appendUnique(items []string, values ...string) []string {
+	for _, value := range values {
+		if !slices.Contains(items, value) {
+			items = append(items, value)
+		}
+	}
+	return items
+}
+
+// PositionIndex maps each byte offset in a text, and the offset of the end of the text, to
+// its zero based position where the column is counted in characters.  Offsets from the
+// lexers are byte offsets.
+type PositionIndex []ast.Position
+
+// NewPositionIndex returns the position of each byte offset in the given text.
+func NewPositionIndex(text string) PositionIndex {
+	result := make(PositionIndex, 0, len(text)+1)
+	row := 0
+	column := 0
+	for offset, c := range text {
+		for len(result) <= offset {
+			result = append(result, ast.Position{
+				Offset: offset,
+				Row:    row,
+				Column: column,
+			})
+		}
+		if c == '\n' {
+			row++
+			column = 0
+		} else {
+			column++
+		}
+	}
+	for len(result) <= len(text) {
+		result = append(result, ast.Position{
+			Offset: len(text),
+			Row:    row,
+			Column: column,
+		})
+	}
+	return result
+}
+
+// At returns the position of the given offset where offsets outside of the text are moved
+// to the start or end of the text.
+func (p PositionIndex) At(offset int) ast.Position {
+	if len(p) == 0 {
+		return ast.Position{}
+	}
+	return p[min(max(offset, 0), len(p)-1)]
+}
+
 func GetPhase4MetaId(node phase4.TopLevelNodeKind) (string, bool) {
 	switch tl := node.(type) {
 	case *phase4.Group:
@@ -119,6 +188,18 @@ func GetAstMetaId(node ast.TopLevelItemKind) (string, bool) {
 			return "", false
 		}
 		return metaId.Id.RawText, true
+	case *ast.TopicGroup:
+		metaId := tl.MetaId
+		if metaId == nil {
+			return "", false
+		}
+		return metaId.Id.RawText, true
+	case *ast.NoteGroup:
+		metaId := tl.MetaId
+		if metaId == nil {
+			return "", false
+		}
+		return metaId.Id.RawText, true
 	default:
 		return "", false
 	}
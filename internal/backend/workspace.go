@@ -17,9 +17,11 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 	"mathlingua/internal/ast"
 	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/formulation"
 	"mathlingua/internal/frontend/structural/phase4"
 	"mathlingua/internal/mlglib"
 )
@@ -96,11 +98,61 @@ type PageResponse struct {
 	Error       string
 	Diagnostics []frontend.Diagnostic
 	Document    phase4.Document
+	// the notes on the entries in the document keyed by the id of the entry
+	Notes map[string][]Note
 }
 
 type EntryResponse struct {
 	Error string
 	Entry phase4.TopLevelNodeKind
+	// Fingerprint is the fingerprint of the entry's statement if the entry is a
+	// Theorem:, Lemma:, Corollary:, or Axiom: and is empty otherwise
+	Fingerprint string
+}
+
+func (r *EntryResponse) UnmarshalJSON(data []byte) error {
+	type alias EntryResponse
+	fields := struct {
+		*alias
+		Entry json.RawMessage
+	}{
+		alias: (*alias)(r),
+	}
+	err := json.Unmarshal(data, &fields)
+	if err == nil {
+		r.Entry, err = phase4.UnmarshalTopLevelNodeKind(fields.Entry)
+	}
+	return err
+}
+
+type FindResponse struct {
+	Error   string
+	Results []FindResult
+}
+
+type HierarchyResponse struct {
+	Error     string
+	Hierarchy *Hierarchy
+}
+
+type HoverResponse struct {
+	Error string
+	Hover *Hover
+}
+
+type DefinitionResponse struct {
+	Error      string
+	Definition *Definition
+}
+
+type TopicsResponse struct {
+	Error  string
+	Topics []Topic
+}
+
+type SemanticTokensResponse struct {
+	Error  string
+	Tokens []SemanticToken
 }
 
 type CheckResult struct {
@@ -124,26 +176,31 @@ type Workspace struct {
 	// map paths to path contents
 	contents []PathLabelContent
 	// the tracker used to record diagnostics
-	diasnosticTracker *frontend.DiagnosticTracker
-	nodeTracker       NodeTracker
-	writtenResolver   WrittenResolver
-	signatureManager  SignatureManager
+	diasnosticTracker  *frontend.DiagnosticTracker
+	nodeTracker        NodeTracker
+	writtenResolver    WrittenResolver
+	signatureManager   SignatureManager
+	suppressionTracker SuppressionTracker
 }
 
 func NewWorkspace(
 	contents []PathLabelContent,
 	diasnosticTracker *frontend.DiagnosticTracker,
+	unicodeTable *formulation.UnicodeTable,
 ) *Workspace {
-	nodeTracker := NewNodeTracker(contents, diasnosticTracker)
+	nodeTracker := NewNodeTracker(contents, diasnosticTracker, unicodeTable)
 	signatureManager := NewSignatureManager(nodeTracker, diasnosticTracker)
-	writtenResolver := NewWrittenResolver(nodeTracker, diasnosticTracker)
+	operatorResolver := NewOperatorResolver(nodeTracker, diasnosticTracker)
+	writtenResolver := NewWrittenResolver(nodeTracker, operatorResolver, diasnosticTracker)
+	suppressionTracker := NewSuppressionTracker(nodeTracker, diasnosticTracker)
 
 	w := Workspace{
-		contents:          contents,
-		diasnosticTracker: diasnosticTracker,
-		nodeTracker:       *nodeTracker,
-		writtenResolver:   *writtenResolver,
-		signatureManager:  *signatureManager,
+		contents:           contents,
+		diasnosticTracker:  diasnosticTracker,
+		nodeTracker:        *nodeTracker,
+		writtenResolver:    *writtenResolver,
+		signatureManager:   *signatureManager,
+		suppressionTracker: *suppressionTracker,
 	}
 	w.initialize(contents)
 	return &w
@@ -157,15 +214,23 @@ func (w *Workspace) DocumentCount() int {
 
 func (w *Workspace) Check() CheckResult {
 	w.signatureManager.findUsedUnknownSignatures()
+	w.signatureManager.findDefinitionCycles()
+	w.signatureManager.findUnknownTopicMembers()
+	w.signatureManager.findUnknownNoteTargets()
+	CheckDuplicateStatements(w.nodeTracker.astRoot, w.diasnosticTracker)
 	for _, pair := range w.Paths() {
 		// get all of the documents to populate the tracker
 		// with any rendering errors
 		path := pair.Path
 		_, astDoc, _ := w.GetDocumentAt(path)
 		CheckRequirements(pair.Path, &astDoc, w.nodeTracker.tracker)
+		phase4Doc, _ := w.nodeTracker.GetDocumentAt(path)
+		CheckIds(pair.Path, &phase4Doc, w.nodeTracker.tracker)
 	}
+	diagnostics := w.suppressionTracker.Filter(w.diasnosticTracker.Diagnostics())
+	diagnostics = append(diagnostics, w.suppressionTracker.GetUnusedSuppressions()...)
 	return CheckResult{
-		Diagnostics: w.diasnosticTracker.Diagnostics(),
+		Diagnostics: diagnostics,
 	}
 }
 
@@ -201,6 +266,16 @@ func (w *Workspace) GetEntryById(id string) (phase4.TopLevelNodeKind, error) {
 	return castResult, nil
 }
 
+// GetFingerprintById returns the fingerprint of the statement of the entry with the given
+// id as described by GetFingerprint.
+func (w *Workspace) GetFingerprintById(id string) (string, bool) {
+	_, astEntry, err := w.nodeTracker.GetEntryById(id)
+	if err != nil {
+		return "", false
+	}
+	return GetFingerprint(astEntry)
+}
+
 func (w *Workspace) GetEntryBySignature(signature string) (phase4.TopLevelNodeKind, error) {
 	id, ok := w.nodeTracker.GetIdForSignature(signature)
 	if !ok {
@@ -228,16 +303,40 @@ func (w *Workspace) getDiagnosticsForPath(path ast.Path) []frontend.Diagnostic {
 			result = append(result, diag)
 		}
 	}
-	return result
+	return w.suppressionTracker.Filter(result)
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // Replace `f(x)` with `f(x) := var'#'` but do not change `f(x) := y`
 // Replace `(a, b)` with `var'#' := (a, b)` but do not change `X := (a, b)`
+// Replace `f(x...) :=: f((x...))` with `f(x...) :=: f((x...)) := var'#'`
+// Replace `(a, b, c) :=: ((a, b), c)` with `var'#' := (a, b, c) :=: ((a, b), c)`
 func replaceMissingIdentifier(target ast.Target, keyGen *mlglib.KeyGenerator) ast.Target {
 	switch f := target.Root.(type) {
-	// TODO: determine if the StructuralColonEqualsColonForm needs to be checked
+	case *ast.StructuralColonEqualsColonForm:
+		name := &ast.NameForm{
+			Text:            fmt.Sprintf("var'%d'", keyGen.Next()),
+			IsStropped:      false,
+			HasQuestionMark: false,
+			VarArg: ast.VarArgData{
+				IsVarArg: false,
+			},
+		}
+		if _, ok := f.Lhs.(*ast.TupleForm); ok {
+			return ast.Target{
+				Root: &ast.StructuralColonEqualsForm{
+					Lhs: name,
+					Rhs: f,
+				},
+			}
+		}
+		return ast.Target{
+			Root: &ast.StructuralColonEqualsForm{
+				Lhs: f,
+				Rhs: name,
+			},
+		}
 	case *ast.FunctionForm:
 		return ast.Target{
 			Root: &ast.StructuralColonEqualsForm{
@@ -284,62 +383,63 @@ func replaceMissingIdentifier(target ast.Target, keyGen *mlglib.KeyGenerator) as
 	return target
 }
 
-func includeMissingIdentifiersInTargets(targets []ast.Target, keyGen *mlglib.KeyGenerator) {
-	for i := range targets {
-		targets[i] = replaceMissingIdentifier(targets[i], keyGen)
-	}
-}
-
 func includeMissingIdentifiersAt(node ast.MlgNodeKind, keyGen *mlglib.KeyGenerator) {
-	if node == nil {
-		return
-	}
+	ast.Walk(node, ast.Visitor{
+		Enter: func(node ast.MlgNodeKind, path []ast.PathItem) bool {
+			if target, ok := node.(*ast.Target); ok && len(path) > 0 &&
+				isIdentifiedTarget(target, path[len(path)-1].Parent) {
+				*target = replaceMissingIdentifier(*target, keyGen)
+			}
+			return true
+		},
+	})
+}
 
-	switch n := node.(type) {
+// isIdentifiedTarget reports whether the given target of the given parent is given an
+// identifier if it does not have one.
+func isIdentifiedTarget(target *ast.Target, parent ast.MlgNodeKind) bool {
+	switch n := parent.(type) {
 	case *ast.DefinesGroup:
-		defines := &n.Defines
-		defines.Defines = replaceMissingIdentifier(defines.Defines, keyGen)
-		if n.Using != nil {
-			includeMissingIdentifiersInTargets(n.Using.Using, keyGen)
-		}
+		return target == &n.Defines.Defines || isUsingTarget(target, n.Using)
 	case *ast.DescribesGroup:
-		describes := &n.Describes
-		describes.Describes = replaceMissingIdentifier(describes.Describes, keyGen)
-		if n.Using != nil {
-			includeMissingIdentifiersInTargets(n.Using.Using, keyGen)
-		}
+		return target == &n.Describes.Describes || isUsingTarget(target, n.Using)
 	case *ast.AxiomGroup:
-		if n.Given != nil {
-			includeMissingIdentifiersInTargets(n.Given.Given, keyGen)
-		}
+		return isGivenTarget(target, n.Given)
 	case *ast.ConjectureGroup:
-		if n.Given != nil {
-			includeMissingIdentifiersInTargets(n.Given.Given, keyGen)
-		}
+		return isGivenTarget(target, n.Given)
 	case *ast.TheoremGroup:
-		if n.Given != nil {
-			includeMissingIdentifiersInTargets(n.Given.Given, keyGen)
-		}
+		return isGivenTarget(target, n.Given)
 	case *ast.CorollaryGroup:
-		if n.Given != nil {
-			includeMissingIdentifiersInTargets(n.Given.Given, keyGen)
-		}
+		return isGivenTarget(target, n.Given)
 	case *ast.LemmaGroup:
-		if n.Given != nil {
-			includeMissingIdentifiersInTargets(n.Given.Given, keyGen)
-		}
+		return isGivenTarget(target, n.Given)
 	case *ast.ForAllGroup:
-		includeMissingIdentifiersInTargets(n.ForAll.Targets, keyGen)
+		return containsTarget(n.ForAll.Targets, target)
 	case *ast.ExistsGroup:
-		includeMissingIdentifiersInTargets(n.Exists.Targets, keyGen)
+		return containsTarget(n.Exists.Targets, target)
 	case *ast.ExistsUniqueGroup:
-		includeMissingIdentifiersInTargets(n.ExistsUnique.Targets, keyGen)
+		return containsTarget(n.ExistsUnique.Targets, target)
 	case *ast.ViewGroup:
-		if n.Using != nil {
-			includeMissingIdentifiersInTargets(n.Using.Using, keyGen)
+		return isUsingTarget(target, n.Using)
+	}
+	return false
+}
+
+func isUsingTarget(target *ast.Target, using *ast.UsingSection) bool {
+	return using != nil && containsTarget(using.Using, target)
+}
+
+func isGivenTarget(target *ast.Target, given *ast.GivenSection) bool {
+	return given != nil && containsTarget(given.Given, target)
+}
+
+// containsTarget reports whether the given target is one of the given targets, as opposed
+// to being equal to one of them.
+func containsTarget(targets []ast.Target, target *ast.Target) bool {
+	for i := range targets {
+		if &targets[i] == target {
+			return true
 		}
 	}
-	node.ForEach(func(subNode ast.MlgNodeKind) {
-		includeMissingIdentifiersAt(subNode, keyGen)
-	})
+	return false
 }
@@ -17,6 +17,10 @@
 package backend
 
 import (
+	"encoding/json"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"mathlingua/internal/mlglib"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -99,3 +103,92 @@ func TestNestedNameToRenderedForm(t *testing.T) {
 	actual := nameToRenderedName("abc_xyz_abc_123", false)
 	assert.Equal(t, expected, actual)
 }
+
+func TestEntryResponseJsonRoundTrip(t *testing.T) {
+	workspace := newTestWorkspace(`[\function:on{A}:to{B}]
+Describes: f
+when: 'f is \function'
+Documented:
+. called: "function from A to B"
+------------------------------------------
+Id: "1"
+`)
+	entry, err := workspace.GetEntryById("1")
+	assert.Nil(t, err)
+
+	expected := EntryResponse{
+		Entry:       entry,
+		Fingerprint: "some fingerprint",
+	}
+	data, err := json.Marshal(expected)
+	assert.Nil(t, err)
+
+	var actual EntryResponse
+	assert.Nil(t, json.Unmarshal(data, &actual))
+	assert.Equal(t, expected, actual)
+}
+
+// newTestWorkspace returns a workspace with a single document with the given content.
+func newTestWorkspace(content string) *Workspace {
+	return NewWorkspace([]PathLabelContent{
+		{
+			Path:    ast.ToPath("test.math"),
+			Label:   "test.math",
+			Content: &content,
+		},
+	}, frontend.NewDiagnosticTracker(), nil)
+}
+
+func TestIncludeMissingIdentifiers(t *testing.T) {
+	newTarget := func() ast.Target {
+		return ast.Target{Root: &ast.FunctionForm{Target: ast.NameForm{Text: "f"}}}
+	}
+	newTargets := func() []ast.Target {
+		return []ast.Target{newTarget()}
+	}
+	defines := &ast.DefinesGroup{
+		Defines: ast.DefinesSection{Defines: newTarget()},
+		Using:   &ast.UsingSection{Using: newTargets()},
+	}
+	describes := &ast.DescribesGroup{
+		Describes: ast.DescribesSection{Describes: newTarget()},
+		Using:     &ast.UsingSection{Using: newTargets()},
+	}
+	axiom := &ast.AxiomGroup{Given: &ast.GivenSection{Given: newTargets()}}
+	conjecture := &ast.ConjectureGroup{Given: &ast.GivenSection{Given: newTargets()}}
+	theorem := &ast.TheoremGroup{Given: &ast.GivenSection{Given: newTargets()}}
+	corollary := &ast.CorollaryGroup{Given: &ast.GivenSection{Given: newTargets()}}
+	lemma := &ast.LemmaGroup{Given: &ast.GivenSection{Given: newTargets()}}
+	forAll := &ast.ForAllGroup{ForAll: ast.ForAllSection{Targets: newTargets()}}
+	exists := &ast.ExistsGroup{Exists: ast.ExistsSection{Targets: newTargets()}}
+	existsUnique := &ast.ExistsUniqueGroup{
+		ExistsUnique: ast.ExistsUniqueSection{Targets: newTargets()},
+	}
+	view := &ast.ViewGroup{Using: &ast.UsingSection{Using: newTargets()}}
+
+	nodes := []ast.MlgNodeKind{defines, describes, axiom, conjecture, theorem, corollary,
+		lemma, forAll, exists, existsUnique, view}
+	for _, node := range nodes {
+		includeMissingIdentifiersAt(node, mlglib.NewKeyGenerator())
+	}
+
+	targets := map[string]ast.Target{
+		"Defines":         defines.Defines.Defines,
+		"Defines.Using":   defines.Using.Using[0],
+		"Describes":       describes.Describes.Describes,
+		"Describes.Using": describes.Using.Using[0],
+		"Axiom":           axiom.Given.Given[0],
+		"Conjecture":      conjecture.Given.Given[0],
+		"Theorem":         theorem.Given.Given[0],
+		"Corollary":       corollary.Given.Given[0],
+		"Lemma":           lemma.Given.Given[0],
+		"ForAll":          forAll.ForAll.Targets[0],
+		"Exists":          exists.Exists.Targets[0],
+		"ExistsUnique":    existsUnique.ExistsUnique.Targets[0],
+		"View.Using":      view.Using.Using[0],
+	}
+	for name, target := range targets {
+		_, ok := target.Root.(*ast.StructuralColonEqualsForm)
+		assert.True(t, ok, "no identifier was included for %s", name)
+	}
+}
@@ -30,6 +30,7 @@ type WrittenResolver struct {
 	// the tracker used to record diagnostics
 	diagnosticTracker *frontend.DiagnosticTracker
 	nodeTracker       *NodeTracker
+	operatorResolver  *OperatorResolver
 	// map ids to summaries of the Documented section
 	documentedSummaries map[string]ast.DocumentedSummary
 	// map ids to summaries of top-level item inputs
@@ -38,11 +39,13 @@ type WrittenResolver struct {
 
 func NewWrittenResolver(
 	nodeTracker *NodeTracker,
+	operatorResolver *OperatorResolver,
 	diagnosticTracker *frontend.DiagnosticTracker,
 ) *WrittenResolver {
 	resolver := WrittenResolver{
 		diagnosticTracker:   diagnosticTracker,
 		nodeTracker:         nodeTracker,
+		operatorResolver:    operatorResolver,
 		documentedSummaries: make(map[string]ast.DocumentedSummary, 0),
 		inputSummaries:      make(map[string]ast.InputSummary, 0),
 	}
@@ -144,6 +147,59 @@ func (w *WrittenResolver) infixCommandToWritten(
 	return w.toWrittenImpl(path, node, sig)
 }
 
+// operatorCallToWritten renders an operator call, for example `a + b`, using the `written:`
+// form of the provided operator it has been resolved to.
+func (w *WrittenResolver) operatorCallToWritten(
+	path ast.Path,
+	node ast.FormulationNodeKind,
+) (string, bool) {
+	provided, ok := w.operatorResolver.GetProvidedOperator(node)
+	if !ok || len(provided.Written) == 0 || len(provided.Written[0].Errors) > 0 {
+		return "", false
+	}
+	lhs, ok := getAliasLhs(provided.Symbol)
+	if !ok {
+		return "", false
+	}
+
+	mapping := make(map[string]ast.MlgNodeKind)
+	switch pattern := lhs.(type) {
+	case *ast.InfixOperatorCallExpression:
+		call, ok := node.(*ast.InfixOperatorCallExpression)
+		if !ok {
+			return "", false
+		}
+		addOperandMapping(pattern.Lhs, call.Lhs, mapping)
+		addOperandMapping(pattern.Rhs, call.Rhs, mapping)
+	case *ast.PrefixOperatorCallExpression:
+		call, ok := node.(*ast.PrefixOperatorCallExpression)
+		if !ok {
+			return "", false
+		}
+		addOperandMapping(pattern.Arg, call.Arg, mapping)
+	case *ast.PostfixOperatorCallExpression:
+		call, ok := node.(*ast.PostfixOperatorCallExpression)
+		if !ok {
+			return "", false
+		}
+		addOperandMapping(pattern.Arg, call.Arg, mapping)
+	default:
+		return "", false
+	}
+	return w.writtenItemsToString(path, provided.Written[0].ParsedWritten, mapping,
+		make(map[string][]ast.MlgNodeKind)), true
+}
+
+func addOperandMapping(
+	pattern ast.ExpressionKind,
+	operand ast.ExpressionKind,
+	mapping map[string]ast.MlgNodeKind,
+) {
+	if name, ok := pattern.(*ast.NameForm); ok {
+		mapping[name.Text] = operand
+	}
+}
+
 func (w *WrittenResolver) commandToWritten(path ast.Path, node *ast.CommandExpression) (string, bool) {
 	sig := GetSignatureStringFromCommand(*node)
 	return w.toWrittenImpl(path, node, sig)
@@ -159,113 +215,15 @@ func (w *WrittenResolver) toWrittenImpl(path ast.Path, node ast.MlgNodeKind, sig
 					if matchResult.MatchMakesSense && len(matchResult.Messages) == 0 {
 						// nolint:ineffassign
 						found = true
-
-						nameToWritten := make(map[string]string)
-						nameToWrittenPlus := make(map[string]string)
-						nameToWrittenMinus := make(map[string]string)
-						nameToWrittenEqual := make(map[string]string)
-
-						varArgNameToWritten := make(map[string][]string)
-						varArgNameToWrittenPlus := make(map[string][]string)
-						varArgNameToWrittenMinus := make(map[string][]string)
-						varArgNameToWrittenEqual := make(map[string][]string)
-
-						for name, exp := range matchResult.Mapping {
-							text := w.formulationNodeToWritten(path, exp)
-							textPlus := getVarPlusQuestionMarkText(text, exp)
-							textMinus := getVarMinusQuestionMarkText(text, exp)
-							textEqual := getVarEqualQuestionMarkText(text)
-
-							nameToWritten[name] = text
-							nameToWrittenPlus[name] = textPlus
-							nameToWrittenMinus[name] = textMinus
-							nameToWrittenEqual[name] = textEqual
-
-							varArgNameToWritten[name] = []string{text}
-							varArgNameToWrittenPlus[name] = []string{textPlus}
-							varArgNameToWrittenMinus[name] = []string{textMinus}
-							varArgNameToWrittenEqual[name] = []string{textEqual}
-						}
-
-						for name, exps := range matchResult.VarArgMapping {
-							values := make([]string, 0)
-							valuesPlus := make([]string, 0)
-							valuesMinus := make([]string, 0)
-							valuesEqual := make([]string, 0)
-							for _, exp := range exps {
-								val := w.formulationNodeToWritten(path, exp)
-								valPlus := getVarPlusQuestionMarkText(val, exp)
-								valMinus := getVarMinusQuestionMarkText(val, exp)
-								valEqual := getVarEqualQuestionMarkText(val)
-
-								values = append(values, val)
-								valuesPlus = append(valuesPlus, valPlus)
-								valuesMinus = append(valuesMinus, valMinus)
-								valuesEqual = append(valuesEqual, valEqual)
-							}
-
-							varArgNameToWritten[name] = values
-							varArgNameToWrittenPlus[name] = valuesPlus
-							varArgNameToWrittenMinus[name] = valuesMinus
-							varArgNameToWrittenEqual[name] = valuesEqual
-
-							if len(values) > 0 {
-								nameToWritten[name] = values[0]
-							}
-
-							if len(valuesPlus) > 0 {
-								nameToWrittenPlus[name] = valuesPlus[0]
-							}
-
-							if len(valuesMinus) > 0 {
-								nameToWrittenMinus[name] = valuesMinus[0]
-							}
-
-							if len(valuesEqual) > 0 {
-								nameToWrittenEqual[name] = valuesEqual[0]
-							}
-						}
-
-						result := ""
-						for _, item := range writtenItems {
-							switch it := item.(type) {
-							case *ast.StringItem:
-								result += it.Text
-							case *ast.SubstitutionItem:
-								if it.IsVarArg {
-									if it.NameSuffix == "+" {
-										result += valuesToString(
-											varArgNameToWrittenPlus[it.Name], it.Prefix, it.Infix, it.Suffix)
-									} else if it.NameSuffix == "-" {
-										result += valuesToString(
-											varArgNameToWrittenMinus[it.Name], it.Prefix, it.Infix, it.Suffix)
-									} else if it.NameSuffix == "=" {
-										result += valuesToString(
-											varArgNameToWrittenEqual[it.Name], it.Prefix, it.Infix, it.Suffix)
-									} else {
-										result += valuesToString(
-											varArgNameToWritten[it.Name], it.Prefix, it.Infix, it.Suffix)
-									}
-								} else {
-									if it.NameSuffix == "+" {
-										result += nameToWrittenPlus[it.Name]
-									} else if it.NameSuffix == "-" {
-										result += nameToWrittenMinus[it.Name]
-									} else if it.NameSuffix == "=" {
-										result += nameToWrittenEqual[it.Name]
-									} else {
-										result += nameToWritten[it.Name]
-									}
-								}
-							}
-						}
-						return result, true
+						return w.writtenItemsToString(
+							path, writtenItems, matchResult.Mapping, matchResult.VarArgMapping), true
 					} else {
 						if matchResult.MatchMakesSense {
 							for _, message := range matchResult.Messages {
 								w.diagnosticTracker.Append(frontend.Diagnostic{
 									Type:     frontend.Error,
 									Origin:   frontend.BackendOrigin,
+									Code:     frontend.SignatureMismatchCode,
 									Message:  message,
 									Path:     path,
 									Position: node.GetCommonMetaData().Start,
@@ -279,18 +237,140 @@ func (w *WrittenResolver) toWrittenImpl(path ast.Path, node ast.MlgNodeKind, sig
 		}
 	}
 	if !found {
-		w.diagnosticTracker.Append(frontend.Diagnostic{
-			Type:   frontend.Error,
-			Origin: frontend.BackendOrigin,
-			Message: fmt.Sprintf(
-				"Signature %s does not have a Documented:called: or Documented:written: section", sig),
-			Path:     path,
-			Position: node.GetCommonMetaData().Start,
-		})
+		w.diagnosticTracker.Append(
+			newMissingWrittenDiagnostic(path, sig, node.GetCommonMetaData().Start))
 	}
 	return "", false
 }
 
+// newMissingWrittenDiagnostic returns the diagnostic reported when the entry with the given
+// signature does not describe how it is written.
+func newMissingWrittenDiagnostic(
+	path ast.Path,
+	sig string,
+	position ast.Position,
+) frontend.Diagnostic {
+	return frontend.Diagnostic{
+		Type:   frontend.Error,
+		Origin: frontend.BackendOrigin,
+		Code:   frontend.MissingWrittenCode,
+		Message: fmt.Sprintf(
+			"Signature %s does not have a Documented:called: or Documented:written: section", sig),
+		Path:     path,
+		Position: position,
+	}
+}
+
+// writtenItemsToString returns the text of the given `written:` items with each
+// substitution, for example `x?`, replaced with the written form of the node it maps to.
+func (w *WrittenResolver) writtenItemsToString(
+	path ast.Path,
+	writtenItems []ast.TextItemKind,
+	mapping map[string]ast.MlgNodeKind,
+	varArgMapping map[string][]ast.MlgNodeKind,
+) string {
+	nameToWritten := make(map[string]string)
+	nameToWrittenPlus := make(map[string]string)
+	nameToWrittenMinus := make(map[string]string)
+	nameToWrittenEqual := make(map[string]string)
+
+	varArgNameToWritten := make(map[string][]string)
+	varArgNameToWrittenPlus := make(map[string][]string)
+	varArgNameToWrittenMinus := make(map[string][]string)
+	varArgNameToWrittenEqual := make(map[string][]string)
+
+	for name, exp := range mapping {
+		text := w.formulationNodeToWritten(path, exp)
+		textPlus := getVarPlusQuestionMarkText(text, exp)
+		textMinus := getVarMinusQuestionMarkText(text, exp)
+		textEqual := getVarEqualQuestionMarkText(text)
+
+		nameToWritten[name] = text
+		nameToWrittenPlus[name] = textPlus
+		nameToWrittenMinus[name] = textMinus
+		nameToWrittenEqual[name] = textEqual
+
+		varArgNameToWritten[name] = []string{text}
+		varArgNameToWrittenPlus[name] = []string{textPlus}
+		varArgNameToWrittenMinus[name] = []string{textMinus}
+		varArgNameToWrittenEqual[name] = []string{textEqual}
+	}
+
+	for name, exps := range varArgMapping {
+		values := make([]string, 0)
+		valuesPlus := make([]string, 0)
+		valuesMinus := make([]string, 0)
+		valuesEqual := make([]string, 0)
+		for _, exp := range exps {
+			val := w.formulationNodeToWritten(path, exp)
+			valPlus := getVarPlusQuestionMarkText(val, exp)
+			valMinus := getVarMinusQuestionMarkText(val, exp)
+			valEqual := getVarEqualQuestionMarkText(val)
+
+			values = append(values, val)
+			valuesPlus = append(valuesPlus, valPlus)
+			valuesMinus = append(valuesMinus, valMinus)
+			valuesEqual = append(valuesEqual, valEqual)
+		}
+
+		varArgNameToWritten[name] = values
+		varArgNameToWrittenPlus[name] = valuesPlus
+		varArgNameToWrittenMinus[name] = valuesMinus
+		varArgNameToWrittenEqual[name] = valuesEqual
+
+		if len(values) > 0 {
+			nameToWritten[name] = values[0]
+		}
+
+		if len(valuesPlus) > 0 {
+			nameToWrittenPlus[name] = valuesPlus[0]
+		}
+
+		if len(valuesMinus) > 0 {
+			nameToWrittenMinus[name] = valuesMinus[0]
+		}
+
+		if len(valuesEqual) > 0 {
+			nameToWrittenEqual[name] = valuesEqual[0]
+		}
+	}
+
+	result := ""
+	for _, item := range writtenItems {
+		switch it := item.(type) {
+		case *ast.StringItem:
+			result += it.Text
+		case *ast.SubstitutionItem:
+			if it.IsVarArg {
+				if it.NameSuffix == "+" {
+					result += valuesToString(
+						varArgNameToWrittenPlus[it.Name], it.Prefix, it.Infix, it.Suffix)
+				} else if it.NameSuffix == "-" {
+					result += valuesToString(
+						varArgNameToWrittenMinus[it.Name], it.Prefix, it.Infix, it.Suffix)
+				} else if it.NameSuffix == "=" {
+					result += valuesToString(
+						varArgNameToWrittenEqual[it.Name], it.Prefix, it.Infix, it.Suffix)
+				} else {
+					result += valuesToString(
+						varArgNameToWritten[it.Name], it.Prefix, it.Infix, it.Suffix)
+				}
+			} else {
+				if it.NameSuffix == "+" {
+					result += nameToWrittenPlus[it.Name]
+				} else if it.NameSuffix == "-" {
+					result += nameToWrittenMinus[it.Name]
+				} else if it.NameSuffix == "=" {
+					result += nameToWrittenEqual[it.Name]
+				} else {
+					result += nameToWritten[it.Name]
+				}
+			}
+		}
+	}
+	return result
+}
+
 func (w *WrittenResolver) formulationNodeToWritten(path ast.Path, mlgNode ast.MlgNodeKind) string {
 	if mlgNode == nil {
 		return ""
@@ -459,6 +539,12 @@ func (w *WrittenResolver) formulationNodeToWritten(path ast.Path, mlgNode ast.Ml
 			return text, true
 		case *ast.CommandExpression:
 			return w.commandToWritten(path, n)
+		case *ast.InfixOperatorCallExpression:
+			return w.operatorCallToWritten(path, n)
+		case *ast.PrefixOperatorCallExpression:
+			return w.operatorCallToWritten(path, n)
+		case *ast.PostfixOperatorCallExpression:
+			return w.operatorCallToWritten(path, n)
 		case *ast.InfixCommandExpression:
 			return w.infixCommandToWritten(path, n)
 		case *ast.AsExpression:
@@ -515,6 +601,7 @@ func (w *WrittenResolver) updateFormulationStrings(
 				w.diagnosticTracker.Append(frontend.Diagnostic{
 					Type:     frontend.Warning,
 					Origin:   frontend.BackendOrigin,
+					Code:     frontend.UnprocessedFormulationCode,
 					Message:  fmt.Sprintf("Could not process: %s", argData.Text),
 					Path:     path,
 					Position: arg.MetaData.Start,
@@ -56,7 +56,7 @@ func ParseConfig(text string) (*Config, error) {
 		}
 		title := ""
 		for index < len(text) && text[index] != '\n' {
-			title += string(text[index])
+			title += text[index : index+1]
 			index++
 		}
 		if !strings.HasPrefix(title, "[") {
@@ -71,7 +71,7 @@ func ParseConfig(text string) (*Config, error) {
 	key := func() (string, error) {
 		result := ""
 		for index < len(text) && text[index] != '=' {
-			result += string(text[index])
+			result += text[index : index+1]
 			index++
 		}
 		if len(result) == 0 {
@@ -92,7 +92,7 @@ func ParseConfig(text string) (*Config, error) {
 		index++
 		result := ""
 		for index < len(text) && (text[index] != '"' || (index > 0 && text[index] == '\\')) {
-			result += string(text[index])
+			result += text[index : index+1]
 			index++
 		}
 		err = expect('"')
@@ -17,11 +17,12 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"mathlingua/internal/ast"
 	"mathlingua/internal/frontend"
 	"os"
-	"path"
 )
 
 const mlg_conf_name = "mlg.conf"
@@ -32,6 +33,7 @@ func LoadMlgConfig(tracker *frontend.DiagnosticTracker) *MlgConfig {
 		tracker.Append(frontend.Diagnostic{
 			Type:   frontend.Warning,
 			Origin: frontend.CliOrigin,
+			Code:   frontend.ConfigErrorCode,
 			Message: fmt.Sprintf("Could not determine if %s exists: "+
 				"Failed to determine the current working directory.\n", mlg_conf_name),
 			Path: ast.ToPath(mlg_conf_name),
@@ -39,8 +41,14 @@ func LoadMlgConfig(tracker *frontend.DiagnosticTracker) *MlgConfig {
 		return &MlgConfig{}
 	}
 
-	content, err := os.ReadFile(path.Join(cwd, mlg_conf_name))
-	if err != nil && os.IsNotExist(err) {
+	return LoadMlgConfigFS(os.DirFS(cwd), tracker)
+}
+
+// LoadMlgConfigFS loads the mlg.conf file at the root of the given file system, or the
+// default config if it does not exist.
+func LoadMlgConfigFS(fsys fs.FS, tracker *frontend.DiagnosticTracker) *MlgConfig {
+	content, err := fs.ReadFile(fsys, mlg_conf_name)
+	if err != nil && errors.Is(err, fs.ErrNotExist) {
 		// if the config file doesn't exist, then use the default config
 		return &MlgConfig{}
 	}
@@ -49,6 +57,7 @@ func LoadMlgConfig(tracker *frontend.DiagnosticTracker) *MlgConfig {
 		tracker.Append(frontend.Diagnostic{
 			Type:    frontend.Error,
 			Origin:  frontend.CliOrigin,
+			Code:    frontend.ConfigErrorCode,
 			Message: fmt.Sprintf("An error occurred while reading %s: %s\n", mlg_conf_name, err),
 			Path:    ast.ToPath(mlg_conf_name),
 		})
@@ -60,6 +69,7 @@ func LoadMlgConfig(tracker *frontend.DiagnosticTracker) *MlgConfig {
 		tracker.Append(frontend.Diagnostic{
 			Type:    frontend.Error,
 			Origin:  frontend.CliOrigin,
+			Code:    frontend.ConfigErrorCode,
 			Message: fmt.Sprintf("An error occurred while parsing %s: %s\n", mlg_conf_name, err),
 			Path:    ast.ToPath(mlg_conf_name),
 		})
@@ -19,10 +19,14 @@ package config
 import (
 	"fmt"
 	"mathlingua/internal/mlglib"
+	"unicode/utf8"
 )
 
 type MlgConfig struct {
 	View MlgViewConfig
+	// maps Unicode symbols to the text they are normalized to in formulations
+	// in addition to (or instead of) the built-in normalizations
+	Unicode map[rune]string
 }
 
 type MlgViewConfig struct {
@@ -54,9 +58,16 @@ func ParseMlgConfig(text string) (*MlgConfig, error) {
 		}
 	}
 
+	unicode, err := parseMlgUnicodeConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
 	viewConf, ok := conf.Section(mlg_view_section_name)
 	if !ok {
-		return &MlgConfig{}, nil
+		return &MlgConfig{
+			Unicode: unicode,
+		}, nil
 	}
 
 	for _, key := range viewConf.Keys() {
@@ -75,9 +86,33 @@ func ParseMlgConfig(text string) (*MlgConfig, error) {
 			Keywords:    keywords,
 			Description: description,
 		},
+		Unicode: unicode,
 	}, nil
 }
 
+func parseMlgUnicodeConfig(conf *Config) (map[rune]string, error) {
+	unicodeConf, ok := conf.Section(mlg_unicode_section_name)
+	if !ok {
+		return nil, nil
+	}
+
+	result := make(map[rune]string)
+	for _, key := range unicodeConf.Keys() {
+		symbol, size := utf8.DecodeRuneInString(key)
+		if size != len(key) {
+			return nil, fmt.Errorf("Expected a single character key in %s: %s",
+				mlg_unicode_section_name, key)
+		}
+		value, _ := unicodeConf.Get(key)
+		if value == "" {
+			return nil, fmt.Errorf("Expected a non-empty value for %s in %s",
+				key, mlg_unicode_section_name)
+		}
+		result[symbol] = value
+	}
+	return result, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
 const title_mlg_view_key = "title"
@@ -86,6 +121,7 @@ const description_mlg_view_key = "description"
 const home_mlg_view_key = "home"
 
 var mlg_view_section_name = "mlg.view"
+var mlg_unicode_section_name = "mlg.unicode"
 
 var expected_mlg_view_keys = buildExpectedMlgViewKeys()
 var expected_mlg_section_names = buildExpectedMlgSectionNames()
@@ -102,5 +138,6 @@ func buildExpectedMlgViewKeys() *mlglib.Set[string] {
 func buildExpectedMlgSectionNames() *mlglib.Set[string] {
 	result := mlglib.NewSet[string]()
 	result.Add(mlg_view_section_name)
+	result.Add(mlg_unicode_section_name)
 	return result
 }
@@ -17,8 +17,10 @@
 package config
 
 import (
+	"mathlingua/internal/frontend"
 	"mathlingua/internal/mlglib"
 	"testing"
+	"testing/fstest"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -41,3 +43,45 @@ keywords = "keyword 1, keyword 2, keyword3"
 		},
 	}), mlglib.PrettyPrint(conf))
 }
+
+func TestParseMlgConfigUnicode(t *testing.T) {
+	input := `
+[mlg.unicode]
+≤ = "<="
+∈ = "\.in./"
+`
+	conf, err := ParseMlgConfig(input)
+	assert.Nil(t, err)
+	assert.Equal(t, map[rune]string{
+		'≤': "<=",
+		'∈': "\\.in./",
+	}, conf.Unicode)
+}
+
+func TestParseMlgConfigUnicodeRequiresSingleCharacterKeys(t *testing.T) {
+	input := `
+[mlg.unicode]
+<= = "≤"
+`
+	_, err := ParseMlgConfig(input)
+	assert.NotNil(t, err)
+}
+
+func TestLoadMlgConfigFS(t *testing.T) {
+	tracker := frontend.NewDiagnosticTracker()
+	conf := LoadMlgConfigFS(fstest.MapFS{
+		"mlg.conf": {Data: []byte("[mlg.view]\ntitle = \"some title\"\n")},
+	}, tracker)
+	assert.Empty(t, tracker.Diagnostics())
+	assert.Equal(t, "some title", conf.View.Title)
+
+	conf = LoadMlgConfigFS(fstest.MapFS{}, tracker)
+	assert.Empty(t, tracker.Diagnostics())
+	assert.Equal(t, MlgConfig{}, *conf)
+
+	LoadMlgConfigFS(fstest.MapFS{
+		"mlg.conf": {Data: []byte("[unknown]\n")},
+	}, tracker)
+	assert.Equal(t, 1, len(tracker.Diagnostics()))
+	assert.Equal(t, frontend.ConfigErrorCode, tracker.Diagnostics()[0].Code)
+}
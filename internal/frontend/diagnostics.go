@@ -19,6 +19,7 @@ package frontend
 import (
 	"fmt"
 	"mathlingua/internal/ast"
+	"strings"
 )
 
 type DiagnosticType string
@@ -44,12 +45,41 @@ const (
 	CliOrigin                     DiagnosticOrigin = "CliOrigin"
 )
 
+// DiagnosticCode identifies the kind of problem a diagnostic describes independent of its
+// message, so that a diagnostic can be referenced, for example, in an mlg:ignore comment.
+type DiagnosticCode string
+
+const (
+	SyntaxErrorCode            DiagnosticCode = "syntax-error"
+	InvalidStructureCode       DiagnosticCode = "invalid-structure"
+	FileSystemErrorCode        DiagnosticCode = "file-system-error"
+	ConfigErrorCode            DiagnosticCode = "config-error"
+	DuplicateSignatureCode     DiagnosticCode = "duplicate-signature"
+	UnrecognizedSignatureCode  DiagnosticCode = "unrecognized-signature"
+	MissingWrittenCode         DiagnosticCode = "missing-written"
+	SignatureMismatchCode      DiagnosticCode = "signature-mismatch"
+	UnprocessedFormulationCode DiagnosticCode = "unprocessed-formulation"
+	InvalidRequirementCode     DiagnosticCode = "invalid-requirement"
+	InvalidSuppressionCode     DiagnosticCode = "invalid-suppression"
+	UnusedSuppressionCode      DiagnosticCode = "unused-suppression"
+	ConflictingOperatorCode    DiagnosticCode = "conflicting-operator"
+	AmbiguousOperatorCode      DiagnosticCode = "ambiguous-operator"
+	UnresolvedOperatorCode     DiagnosticCode = "unresolved-operator"
+	DuplicateStatementCode     DiagnosticCode = "duplicate-statement"
+	CircularDefinitionCode     DiagnosticCode = "circular-definition"
+	MissingIdCode              DiagnosticCode = "missing-id"
+	SectionOrderCode           DiagnosticCode = "section-order"
+)
+
 type Diagnostic struct {
 	Type     DiagnosticType
 	Origin   DiagnosticOrigin
+	Code     DiagnosticCode
 	Message  string
 	Path     ast.Path
 	Position ast.Position
+	// possible replacements for the text the diagnostic is about, most likely first
+	Suggestions []string
 }
 
 func (diag *Diagnostic) String() string {
@@ -59,8 +89,22 @@ func (diag *Diagnostic) String() string {
 	} else {
 		prefix = "WARNING"
 	}
-	return fmt.Sprintf("%s: %s (%d, %d)\n%s",
-		prefix, diag.Path, diag.Position.Row+1, diag.Position.Column+1, diag.Message)
+	code := ""
+	if diag.Code != "" {
+		code = fmt.Sprintf(" [%s]", diag.Code)
+	}
+	return fmt.Sprintf("%s: %s (%d, %d)%s\n%s%s",
+		prefix, diag.Path, diag.Position.Row+1, diag.Position.Column+1, code, diag.Message,
+		diag.SuggestionsText())
+}
+
+// SuggestionsText returns a line describing the diagnostic's suggestions (with a
+// leading newline) or the empty string if it doesn't have any suggestions.
+func (diag *Diagnostic) SuggestionsText() string {
+	if len(diag.Suggestions) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\nDid you mean: %s", strings.Join(diag.Suggestions, ", "))
 }
 
 func NewDiagnosticTracker() *DiagnosticTracker {
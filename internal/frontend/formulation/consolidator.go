@@ -28,13 +28,15 @@ func Consolidate(
 	path ast.Path,
 	nodes []ast.FormulationNodeKind,
 	tracker *frontend.DiagnosticTracker,
+	operators *OperatorTable,
 ) (ast.FormulationNodeKind, bool) {
-	if colonArrowDash, ok := maybeProcessExpressionColonDashArrowItem(path, nodes, tracker); ok {
+	if colonArrowDash, ok := maybeProcessExpressionColonDashArrowItem(
+		path, nodes, tracker, operators); ok {
 		return &colonArrowDash, true
 	}
 
 	items := mlglib.NewStack[ShuntingYardItem[ast.FormulationNodeKind]]()
-	for _, item := range ShuntingYard(toShuntingYardItems(nodes)) {
+	for _, item := range ShuntingYard(toShuntingYardItems(nodes, operators)) {
 		items.Push(item)
 	}
 
@@ -51,11 +53,11 @@ func Consolidate(
 	return top, stack.IsEmpty()
 }
 
-func GetPrecedenceAndIfInfix(node ast.ExpressionKind) (int, bool) {
+func GetPrecedenceAndIfInfix(node ast.ExpressionKind, operators *OperatorTable) (int, bool) {
 	if _, infixOk := node.(*ast.InfixOperatorCallExpression); !infixOk {
 		return -1, false
 	}
-	precedence, _ := getPrecedenceAssociativity(node, InfixOperatorType)
+	precedence, _ := getPrecedenceAssociativity(node, InfixOperatorType, operators)
 	return precedence, true
 }
 
@@ -65,6 +67,7 @@ func maybeProcessExpressionColonDashArrowItem(
 	path ast.Path,
 	nodes []ast.FormulationNodeKind,
 	tracker *frontend.DiagnosticTracker,
+	operators *OperatorTable,
 ) (ast.ExpressionColonDashArrowItem, bool) {
 	index := -1
 	for i, n := range nodes {
@@ -85,7 +88,7 @@ func maybeProcessExpressionColonDashArrowItem(
 		lhsNodes = append(lhsNodes, nodes[i])
 	}
 
-	lhs, ok := Consolidate(path, lhsNodes, tracker)
+	lhs, ok := Consolidate(path, lhsNodes, tracker, operators)
 	if !ok {
 		return ast.ExpressionColonDashArrowItem{}, false
 	}
@@ -107,7 +110,7 @@ func maybeProcessExpressionColonDashArrowItem(
 			}
 			partNodes = append(partNodes, cur)
 		}
-		part, ok := Consolidate(path, partNodes, tracker)
+		part, ok := Consolidate(path, partNodes, tracker, operators)
 		if !ok {
 			return ast.ExpressionColonDashArrowItem{}, false
 		}
@@ -167,6 +170,9 @@ func toNode(
 			return &ast.PrefixOperatorCallExpression{
 				Target: target,
 				Arg:    arg,
+				CommonMetaData: ast.CommonMetaData{
+					Start: target.Start(),
+				},
 			}
 		} else if rawTop.ItemType == PostfixOperatorType {
 			arg := checkType(path, toNode(path, items, tracker), default_expression, "Expression",
@@ -174,6 +180,9 @@ func toNode(
 			return &ast.PostfixOperatorCallExpression{
 				Target: target,
 				Arg:    arg,
+				CommonMetaData: ast.CommonMetaData{
+					Start: arg.Start(),
+				},
 			}
 		} else {
 			// it is an infix
@@ -185,6 +194,9 @@ func toNode(
 				Target: target,
 				Lhs:    rhs,
 				Rhs:    lhs,
+				CommonMetaData: ast.CommonMetaData{
+					Start: rhs.Start(),
+				},
 			}
 		}
 	case *ast.NonEnclosedNonCommandOperatorTarget:
@@ -196,6 +208,9 @@ func toNode(
 			return &ast.PrefixOperatorCallExpression{
 				Target: target,
 				Arg:    arg,
+				CommonMetaData: ast.CommonMetaData{
+					Start: target.Start(),
+				},
 			}
 		} else if rawTop.ItemType == PostfixOperatorType {
 			arg := checkType(path, toNode(path, items, tracker), default_expression, "Expression",
@@ -203,6 +218,9 @@ func toNode(
 			return &ast.PostfixOperatorCallExpression{
 				Target: target,
 				Arg:    arg,
+				CommonMetaData: ast.CommonMetaData{
+					Start: arg.Start(),
+				},
 			}
 		} else {
 			// it is an infix
@@ -214,6 +232,9 @@ func toNode(
 				Target: target,
 				Lhs:    rhs,
 				Rhs:    lhs,
+				CommonMetaData: ast.CommonMetaData{
+					Start: rhs.Start(),
+				},
 			}
 		}
 	case *ast.InfixCommandExpression:
@@ -227,6 +248,9 @@ func toNode(
 			Target: target,
 			Lhs:    rhs,
 			Rhs:    lhs,
+			CommonMetaData: ast.CommonMetaData{
+				Start: rhs.Start(),
+			},
 		}
 	case *ast.InfixCommandTypeForm:
 		// for example \:f:/
@@ -239,6 +263,9 @@ func toNode(
 			Target: target,
 			Lhs:    rhs,
 			Rhs:    lhs,
+			CommonMetaData: ast.CommonMetaData{
+				Start: rhs.Start(),
+			},
 		}
 	case *ast.PseudoTokenNode:
 		// a token, for example :=, :=:, :=>, :->, is
@@ -299,6 +326,7 @@ const (
 
 func toShuntingYardItems(
 	nodes []ast.FormulationNodeKind,
+	operators *OperatorTable,
 ) []ShuntingYardItem[ast.FormulationNodeKind] {
 	result := make([]ShuntingYardItem[ast.FormulationNodeKind], 0)
 	firstPassTypes := make([]firstPassType, len(nodes))
@@ -329,7 +357,7 @@ func toShuntingYardItems(
 	}
 
 	for i, node := range nodes {
-		prec, assoc := getPrecedenceAssociativity(node, itemTypes[i])
+		prec, assoc := getPrecedenceAssociativity(node, itemTypes[i], operators)
 		result = append(result, ShuntingYardItem[ast.FormulationNodeKind]{
 			Item:          node,
 			ItemType:      itemTypes[i],
@@ -510,17 +538,24 @@ func getOperatorPrecedenceAssociativityByText(
 func getPrecedenceAssociativity(
 	node ast.FormulationNodeKind,
 	itemType ItemType,
+	operators *OperatorTable,
 ) (int, Associativity) {
+	if text, ok := GetOperatorText(node); ok {
+		if info, ok := operators.Lookup(text, itemType); ok {
+			return info.Precedence, info.Associativity
+		}
+	}
+
 	switch node := node.(type) {
 	case *ast.PrefixOperatorCallExpression:
 		// prefix operators
-		return getPrecedenceAssociativity(node.Target, itemType)
+		return getPrecedenceAssociativity(node.Target, itemType, operators)
 	case *ast.PostfixOperatorCallExpression:
 		// postfix operators
-		return getPrecedenceAssociativity(node.Target, itemType)
+		return getPrecedenceAssociativity(node.Target, itemType, operators)
 	case *ast.InfixOperatorCallExpression:
 		// infix operators
-		return getPrecedenceAssociativity(node.Target, itemType)
+		return getPrecedenceAssociativity(node.Target, itemType, operators)
 	case *ast.EnclosedNonCommandOperatorTarget:
 		// for example [x]
 		return enclosed_infix_precedence, enclosed_infix_associativity
@@ -561,6 +596,7 @@ func checkType[T any](
 			Type:     frontend.Error,
 			Path:     path,
 			Origin:   frontend.FormulationConsolidatorOrigin,
+			Code:     frontend.SyntaxErrorCode,
 			Message:  fmt.Sprintf("Expected a %s but found %s", typeName, mlglib.PrettyPrint(node)),
 			Position: position,
 		})
@@ -23,15 +23,27 @@ import (
 	"unicode"
 )
 
-func NewLexer(path ast.Path, text string, tracker *frontend.DiagnosticTracker) *frontend.Lexer {
-	return frontend.NewLexer(getTokens(path, text, tracker))
+// NewLexer returns a lexer for the given formulation text where Unicode symbols are
+// normalized using the given table, or the default table if it is nil.
+func NewLexer(
+	path ast.Path,
+	text string,
+	tracker *frontend.DiagnosticTracker,
+	unicodeTable *UnicodeTable,
+) *frontend.Lexer {
+	return frontend.NewLexer(getTokens(path, text, tracker, unicodeTable))
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func getTokens(path ast.Path, text string, tracker *frontend.DiagnosticTracker) []ast.Token {
+func getTokens(
+	path ast.Path,
+	text string,
+	tracker *frontend.DiagnosticTracker,
+	unicodeTable *UnicodeTable,
+) []ast.Token {
 	tokens := make([]ast.Token, 0)
-	chars := frontend.GetChars(text)
+	chars := normalizeChars(frontend.GetChars(text), unicodeTable)
 	i := 0
 
 	appendToken := func(token ast.Token) {
@@ -43,6 +55,7 @@ func getTokens(path ast.Path, text string, tracker *frontend.DiagnosticTracker)
 			Type:     frontend.Error,
 			Path:     path,
 			Origin:   frontend.FormulationLexerOrigin,
+			Code:     frontend.SyntaxErrorCode,
 			Message:  message,
 			Position: position,
 		})
@@ -439,5 +452,7 @@ func isOperatorSymbol(c rune) bool {
 		c == '|' ||
 		c == '<' ||
 		c == '>' ||
-		c == '`'
+		c == '`' ||
+		// Unicode mathematical operators such as ∀, ∈, and ⊗
+		(c > unicode.MaxASCII && unicode.Is(unicode.Sm, c))
 }
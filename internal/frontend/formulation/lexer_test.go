@@ -30,7 +30,7 @@ func TestFormulationLexer(t *testing.T) {
 xyzABC123 +*-? f(x, y, z) [x]{(a, b) | a ; b} f(x...) \command[x]_{a}^{b}:f{x}(y) x.y x is `+
 		`\something/ x as \[something] "*+" name @ extends (. .)|->abc=:->....[..] `+
 		"{..}[||]{::}:=: name` *+`$",
-		tracker)
+		tracker, nil)
 
 	actual := "\n"
 	for lexer.HasNext() {
@@ -132,7 +132,7 @@ abc Name
 
 func TestFormulationLexerMultiNames(t *testing.T) {
 	tracker := frontend.NewDiagnosticTracker()
-	lexer := NewLexer("/some/path", "a b c", tracker)
+	lexer := NewLexer("/some/path", "a b c", tracker, nil)
 
 	actual := "\n"
 	for lexer.HasNext() {
@@ -152,7 +152,7 @@ c Name
 
 func TestFormulationLexerFunctionVarArg(t *testing.T) {
 	tracker := frontend.NewDiagnosticTracker()
-	lexer := NewLexer("/some/path", "f(x)...", tracker)
+	lexer := NewLexer("/some/path", "f(x)...", tracker, nil)
 
 	actual := "\n"
 	for lexer.HasNext() {
@@ -0,0 +1,115 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package formulation
+
+import (
+	"mathlingua/internal/ast"
+	"strings"
+)
+
+type OperatorInfo struct {
+	Precedence    int
+	Associativity Associativity
+}
+
+// OperatorTable records the precedence and associativity declared for operators.  Operators
+// that are not in the table (or a nil table) use the built-in precedence and associativity.
+type OperatorTable struct {
+	operators map[operatorTableKey]OperatorInfo
+}
+
+func NewOperatorTable() *OperatorTable {
+	return &OperatorTable{
+		operators: make(map[operatorTableKey]OperatorInfo),
+	}
+}
+
+func (ot *OperatorTable) Declare(text string, itemType ItemType, info OperatorInfo) {
+	ot.operators[operatorTableKey{
+		text:     text,
+		itemType: itemType,
+	}] = info
+}
+
+func (ot *OperatorTable) Lookup(text string, itemType ItemType) (OperatorInfo, bool) {
+	if ot == nil {
+		return OperatorInfo{}, false
+	}
+	info, ok := ot.operators[operatorTableKey{
+		text:     text,
+		itemType: itemType,
+	}]
+	return info, ok
+}
+
+// DefaultOperatorInfo returns the built-in precedence and associativity of the given operator.
+func DefaultOperatorInfo(node ast.FormulationNodeKind, itemType ItemType) OperatorInfo {
+	precedence, associativity := getPrecedenceAssociativity(node, itemType, nil)
+	return OperatorInfo{
+		Precedence:    precedence,
+		Associativity: associativity,
+	}
+}
+
+// GetOperatorText returns the text used to identify the given operator in an OperatorTable.
+// For example, `**` for `x ** y` and `\.circ./` for `x \.circ./ y`.
+func GetOperatorText(node ast.FormulationNodeKind) (string, bool) {
+	switch node := node.(type) {
+	case *ast.PrefixOperatorCallExpression:
+		return GetOperatorText(node.Target)
+	case *ast.PostfixOperatorCallExpression:
+		return GetOperatorText(node.Target)
+	case *ast.InfixOperatorCallExpression:
+		return GetOperatorText(node.Target)
+	case *ast.NonEnclosedNonCommandOperatorTarget:
+		return node.Text, true
+	case *ast.InfixCommandExpression:
+		names := make([]string, 0, len(node.Names))
+		for _, name := range node.Names {
+			names = append(names, name.Text)
+		}
+		return "\\." + strings.Join(names, ".") + "./", true
+	case *ast.PseudoTokenNode:
+		if node.Type == ast.Operator {
+			return node.Text, true
+		}
+		return "", false
+	default:
+		return "", false
+	}
+}
+
+// GetOperatorItemType returns whether the operator call is a prefix, postfix, or infix call.
+func GetOperatorItemType(node ast.FormulationNodeKind) (ItemType, bool) {
+	switch node.(type) {
+	case *ast.PrefixOperatorCallExpression:
+		return PrefixOperatorType, true
+	case *ast.PostfixOperatorCallExpression:
+		return PostfixOperatorType, true
+	case *ast.InfixOperatorCallExpression:
+		return InfixOperatorType, true
+	default:
+		return OperandType, false
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type operatorTableKey struct {
+	text     string
+	itemType ItemType
+}
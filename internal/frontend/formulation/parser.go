@@ -31,15 +31,18 @@ func ParseExpression(
 	start ast.Position,
 	tracker *frontend.DiagnosticTracker,
 	keyGen *mlglib.KeyGenerator,
+	operators *OperatorTable,
+	unicodeTable *UnicodeTable,
 ) (ast.FormulationNodeKind, bool) {
 	numDiagBefore := tracker.Length()
-	lexer := NewLexer(path, text, tracker)
+	lexer := NewLexer(path, text, tracker, unicodeTable)
 	parser := formulationParser{
-		path:    path,
-		lexer:   lexer,
-		tracker: tracker,
-		start:   start,
-		keyGen:  keyGen,
+		path:      path,
+		lexer:     lexer,
+		tracker:   tracker,
+		start:     start,
+		keyGen:    keyGen,
+		operators: operators,
 	}
 	node, _ := parser.multiplexedExpressionKind()
 	parser.finalize()
@@ -52,15 +55,18 @@ func ParseForm(
 	start ast.Position,
 	tracker *frontend.DiagnosticTracker,
 	keyGen *mlglib.KeyGenerator,
+	operators *OperatorTable,
+	unicodeTable *UnicodeTable,
 ) (ast.FormulationNodeKind, bool) {
 	numDiagBefore := tracker.Length()
-	lexer := NewLexer(path, text, tracker)
+	lexer := NewLexer(path, text, tracker, unicodeTable)
 	parser := formulationParser{
-		path:    path,
-		lexer:   lexer,
-		tracker: tracker,
-		start:   start,
-		keyGen:  keyGen,
+		path:      path,
+		lexer:     lexer,
+		tracker:   tracker,
+		start:     start,
+		keyGen:    keyGen,
+		operators: operators,
 	}
 	node, _ := parser.structuralFormKindPossiblyWithColonEquals()
 	parser.finalize()
@@ -73,15 +79,18 @@ func ParseId(
 	start ast.Position,
 	tracker *frontend.DiagnosticTracker,
 	keyGen *mlglib.KeyGenerator,
+	operators *OperatorTable,
+	unicodeTable *UnicodeTable,
 ) (ast.IdKind, bool) {
 	numDiagBefore := tracker.Length()
-	lexer := NewLexer(path, text, tracker)
+	lexer := NewLexer(path, text, tracker, unicodeTable)
 	parser := formulationParser{
-		path:    path,
-		lexer:   lexer,
-		tracker: tracker,
-		start:   start,
-		keyGen:  keyGen,
+		path:      path,
+		lexer:     lexer,
+		tracker:   tracker,
+		start:     start,
+		keyGen:    keyGen,
+		operators: operators,
 	}
 	node, _ := parser.idKind()
 	parser.finalize()
@@ -94,9 +103,10 @@ func ParseSignature(
 	start ast.Position,
 	tracker *frontend.DiagnosticTracker,
 	keyGen *mlglib.KeyGenerator,
+	unicodeTable *UnicodeTable,
 ) (ast.Signature, bool) {
 	numDiagBefore := tracker.Length()
-	lexer := NewLexer(path, text, tracker)
+	lexer := NewLexer(path, text, tracker, unicodeTable)
 	parser := formulationParser{
 		path:    path,
 		lexer:   lexer,
@@ -117,6 +127,8 @@ type formulationParser struct {
 	tracker *frontend.DiagnosticTracker
 	start   ast.Position
 	keyGen  *mlglib.KeyGenerator
+	// the declared operator precedences or nil to use the built-in precedences
+	operators *OperatorTable
 }
 
 func (fp *formulationParser) token(tokenType ast.TokenType) (ast.Token, bool) {
@@ -152,6 +164,7 @@ func (fp *formulationParser) errorAt(message string, position ast.Position) {
 		Type:     frontend.Error,
 		Path:     fp.path,
 		Origin:   frontend.FormulationParserOrigin,
+		Code:     frontend.SyntaxErrorCode,
 		Message:  message,
 		Position: fp.getShiftedPosition(position),
 	})
@@ -526,7 +539,7 @@ func (fp *formulationParser) multiplexedExpressionKind() (ast.ExpressionKind, bo
 			isIndex = i
 		}
 
-		prec, isInfix := GetPrecedenceAndIfInfix(item)
+		prec, isInfix := GetPrecedenceAndIfInfix(item, fp.operators)
 		if isInfix {
 			if prec >= minPrec {
 				minPrec = prec
@@ -627,7 +640,7 @@ func max(x, y int) int {
 func (fp *formulationParser) expressionKind(
 	additionalTerminators ...ast.TokenType) (ast.ExpressionKind, bool) {
 	if exp, ok := fp.pseudoExpression(additionalTerminators...); ok {
-		res, consolidateOk := Consolidate(fp.path, exp.Children, fp.tracker)
+		res, consolidateOk := Consolidate(fp.path, exp.Children, fp.tracker, fp.operators)
 		if resAsExp, resAsExpOk := res.(ast.ExpressionKind); resAsExpOk {
 			return resAsExp, consolidateOk
 		} else {
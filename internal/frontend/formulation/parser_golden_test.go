@@ -78,7 +78,7 @@ func TestExpressionParserSmoke(t *testing.T) {
 				Column: 0,
 			}
 			keyGenerator := mlglib.NewKeyGenerator()
-			exp, ok := ParseExpression(path, text, start, tracker, keyGenerator)
+			exp, ok := ParseExpression(path, text, start, tracker, keyGenerator, nil, nil)
 			output := ""
 			if ok {
 				output = exp.ToCode(ast.NoOp)
@@ -100,7 +100,7 @@ func TestFormParserSmoke(t *testing.T) {
 				Column: 0,
 			}
 			keyGenerator := mlglib.NewKeyGenerator()
-			form, ok := ParseForm(path, text, start, tracker, keyGenerator)
+			form, ok := ParseForm(path, text, start, tracker, keyGenerator, nil, nil)
 			output := ""
 			if ok {
 				output = form.ToCode(ast.NoOp)
@@ -122,7 +122,7 @@ func TestIdParserSmoke(t *testing.T) {
 				Column: 0,
 			}
 			keyGenerator := mlglib.NewKeyGenerator()
-			id, ok := ParseId(path, text, start, tracker, keyGenerator)
+			id, ok := ParseId(path, text, start, tracker, keyGenerator, nil, nil)
 			output := ""
 			if ok {
 				output = id.ToCode(ast.NoOp)
@@ -144,7 +144,7 @@ func TestSignatureParserSmoke(t *testing.T) {
 				Column: 0,
 			}
 			keyGenerator := mlglib.NewKeyGenerator()
-			signature, ok := ParseSignature(path, text, start, tracker, keyGenerator)
+			signature, ok := ParseSignature(path, text, start, tracker, keyGenerator, nil)
 			output := ""
 			if ok {
 				output = signature.ToCode(ast.NoOp)
@@ -27,7 +27,8 @@ import (
 
 func parseExpression(text string) (ast.FormulationNodeKind, *frontend.DiagnosticTracker) {
 	tracker := frontend.NewDiagnosticTracker()
-	node, _ := ParseExpression("/some/path", text, ast.Position{}, tracker, mlglib.NewKeyGenerator())
+	node, _ := ParseExpression(
+		"/some/path", text, ast.Position{}, tracker, mlglib.NewKeyGenerator(), nil, nil)
 	return node, tracker
 }
 
@@ -52,7 +53,8 @@ func runExpressionTest(t *testing.T, input string, expected string) {
 
 func parseIdForm(text string) (ast.FormulationNodeKind, *frontend.DiagnosticTracker) {
 	tracker := frontend.NewDiagnosticTracker()
-	node, _ := ParseId("/some/path", text, ast.Position{}, tracker, mlglib.NewKeyGenerator())
+	node, _ := ParseId(
+		"/some/path", text, ast.Position{}, tracker, mlglib.NewKeyGenerator(), nil, nil)
 	return node, tracker
 }
 
@@ -73,7 +75,8 @@ func runIdFormTest(t *testing.T, input string, expected string) {
 
 func parseForm(text string) (ast.FormulationNodeKind, *frontend.DiagnosticTracker) {
 	tracker := frontend.NewDiagnosticTracker()
-	node, _ := ParseForm("/some/path", text, ast.Position{}, tracker, mlglib.NewKeyGenerator())
+	node, _ := ParseForm(
+		"/some/path", text, ast.Position{}, tracker, mlglib.NewKeyGenerator(), nil, nil)
 	return node, tracker
 }
 
@@ -162,3 +165,47 @@ func TestConditionalSetIdForm(t *testing.T) {
 	runIdFormTest(t, "\\set[x]{x | p(x)}", "\\set{[x]{x | p(x)}}")
 	runIdFormTest(t, "\\set[x]{x : s(x) | p(x)}", "\\set{[x]{x : s(x) | p(x)}}")
 }
+
+func TestDeclaredOperatorAssociativity(t *testing.T) {
+	operators := NewOperatorTable()
+	operators.Declare("**", InfixOperatorType, OperatorInfo{
+		Precedence:    caret_precedence,
+		Associativity: RightAssociative,
+	})
+
+	tracker := frontend.NewDiagnosticTracker()
+	node, ok := ParseExpression(
+		"/some/path", "a ** b ** c", ast.Position{}, tracker, mlglib.NewKeyGenerator(), operators,
+		nil)
+	assert.True(t, ok)
+	infix, ok := node.(*ast.InfixOperatorCallExpression)
+	assert.True(t, ok)
+	assert.Equal(t, "a", infix.Lhs.ToCode(ast.NoOp))
+	assert.Equal(t, "b ** c", infix.Rhs.ToCode(ast.NoOp))
+
+	node, ok = ParseExpression(
+		"/some/path", "a ** b ** c", ast.Position{}, tracker, mlglib.NewKeyGenerator(), nil, nil)
+	assert.True(t, ok)
+	infix, ok = node.(*ast.InfixOperatorCallExpression)
+	assert.True(t, ok)
+	assert.Equal(t, "a ** b", infix.Lhs.ToCode(ast.NoOp))
+	assert.Equal(t, "c", infix.Rhs.ToCode(ast.NoOp))
+}
+
+func TestDeclaredOperatorPrecedence(t *testing.T) {
+	operators := NewOperatorTable()
+	operators.Declare("\\.circ./", InfixOperatorType, OperatorInfo{
+		Precedence:    times_divide_precedence + 1,
+		Associativity: LeftAssociative,
+	})
+
+	tracker := frontend.NewDiagnosticTracker()
+	node, ok := ParseExpression(
+		"/some/path", "f * g \\.circ./ h", ast.Position{}, tracker, mlglib.NewKeyGenerator(),
+		operators, nil)
+	assert.True(t, ok)
+	infix, ok := node.(*ast.InfixOperatorCallExpression)
+	assert.True(t, ok)
+	assert.Equal(t, "f", infix.Lhs.ToCode(ast.NoOp))
+	assert.Equal(t, "g \\.circ./ h", infix.Rhs.ToCode(ast.NoOp))
+}
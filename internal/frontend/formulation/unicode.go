@@ -0,0 +1,315 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package formulation
+
+import (
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// UnicodeTable maps Unicode symbols to the canonical ASCII or command text they are
+// normalized to when a formulation is lexed.  For example, `≤` is normalized to `<=`,
+// `∈` is normalized to `\.in./`, and `α` is normalized to `alpha`.  A nil table is the
+// same as the default table.
+type UnicodeTable struct {
+	toAscii   map[rune]string
+	toUnicode map[string]rune
+}
+
+func NewUnicodeTable() *UnicodeTable {
+	return &UnicodeTable{
+		toAscii:   make(map[rune]string),
+		toUnicode: make(map[string]rune),
+	}
+}
+
+// DefaultUnicodeTable returns a table with the built-in normalizations of common
+// mathematical operators and the Greek letters.
+func DefaultUnicodeTable() *UnicodeTable {
+	table := NewUnicodeTable()
+	for _, entry := range defaultUnicodeEntries {
+		table.Add(entry.symbol, entry.ascii)
+	}
+	for _, entry := range defaultUnicodeAliases {
+		table.AddAlias(entry.symbol, entry.ascii)
+	}
+	return table
+}
+
+// Add records that the given symbol is normalized to the given text, replacing any
+// normalization previously recorded for the symbol.
+func (ut *UnicodeTable) Add(symbol rune, ascii string) {
+	if prev, ok := ut.toAscii[symbol]; ok && ut.toUnicode[prev] == symbol {
+		delete(ut.toUnicode, prev)
+	}
+	ut.toAscii[symbol] = ascii
+	ut.toUnicode[ascii] = symbol
+}
+
+// AddAlias records that the given symbol is normalized to the given text when it is lexed
+// but, unlike Add, the text is never replaced by the symbol when converting to Unicode.
+// This is used for symbols that look like their ASCII text, such as `−` for `-`.
+func (ut *UnicodeTable) AddAlias(symbol rune, ascii string) {
+	if prev, ok := ut.toAscii[symbol]; ok && ut.toUnicode[prev] == symbol {
+		delete(ut.toUnicode, prev)
+	}
+	ut.toAscii[symbol] = ascii
+}
+
+func (ut *UnicodeTable) ToAscii(symbol rune) (string, bool) {
+	if ut == nil {
+		ut = builtinUnicodeTable
+	}
+	ascii, ok := ut.toAscii[symbol]
+	return ascii, ok
+}
+
+func (ut *UnicodeTable) ToUnicode(ascii string) (rune, bool) {
+	if ut == nil {
+		ut = builtinUnicodeTable
+	}
+	symbol, ok := ut.toUnicode[ascii]
+	return symbol, ok
+}
+
+// ToAsciiText returns the given formulation text with its Unicode symbols replaced by
+// their normalized forms.
+func ToAsciiText(text string, unicodeTable *UnicodeTable) string {
+	result := strings.Builder{}
+	for _, c := range normalizeChars(frontend.GetChars(text), unicodeTable) {
+		result.WriteRune(c.Symbol)
+	}
+	return result.String()
+}
+
+// ToUnicodeText returns the given formulation text with each name, operator, or command
+// that is the normalized form of a Unicode symbol replaced by that symbol.
+func ToUnicodeText(text string, unicodeTable *UnicodeTable) string {
+	if unicodeTable == nil {
+		unicodeTable = builtinUnicodeTable
+	}
+	commands := unicodeTable.getCommandForms()
+	// the text is only rewritten and so lexer errors are ignored
+	tokens := getTokens("", text, frontend.NewDiagnosticTracker(), unicodeTable)
+	result := strings.Builder{}
+	offset := 0
+	for i, token := range tokens {
+		start := token.Position.Offset
+		if start < offset {
+			// the token is part of text that was already replaced
+			continue
+		}
+		if token.Type == ast.BackSlash {
+			if command, ok := getCommandFormAt(text, start, commands); ok {
+				symbol, _ := unicodeTable.ToUnicode(command)
+				result.WriteString(text[offset:start])
+				result.WriteRune(symbol)
+				offset = start + len(command)
+			}
+			continue
+		}
+		if i > 0 && (tokens[i-1].Type == ast.BackSlash || tokens[i-1].Type == ast.Dot) {
+			// the names in commands, such as \beta, are not symbols
+			continue
+		}
+		end := start + len(token.Text)
+		if end > len(text) || text[start:end] != token.Text {
+			// the token was normalized from a Unicode symbol already
+			continue
+		}
+		replaced := token.Text
+		if token.Type == ast.Name {
+			// names such as alpha1 and alpha_1 only have their base name replaced
+			replaced = strings.TrimRight(strings.SplitN(replaced, "_", 2)[0], "0123456789")
+		}
+		if symbol, ok := unicodeTable.ToUnicode(replaced); ok {
+			result.WriteString(text[offset:start])
+			result.WriteRune(symbol)
+			offset = start + len(replaced)
+		}
+	}
+	result.WriteString(text[offset:])
+	return result.String()
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// builtinUnicodeTable is used when no table is given and must not be modified.
+var builtinUnicodeTable = DefaultUnicodeTable()
+
+// getCommandForms returns the normalized forms in the table that are commands, such as
+// `\.in./`, with the longest forms first so that `\.subset.eq./` is preferred to `\.subset./`.
+func (ut *UnicodeTable) getCommandForms() []string {
+	result := make([]string, 0)
+	for ascii := range ut.toUnicode {
+		if isCommandForm(ascii) {
+			result = append(result, ascii)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if len(result[i]) != len(result[j]) {
+			return len(result[i]) > len(result[j])
+		}
+		return result[i] < result[j]
+	})
+	return result
+}
+
+// getCommandFormAt returns the command form that starts at the given offset of the text,
+// if any, where `\forall` is not found in `\forallx`.
+func getCommandFormAt(text string, offset int, commands []string) (string, bool) {
+	for _, command := range commands {
+		if !strings.HasPrefix(text[offset:], command) {
+			continue
+		}
+		next, _ := utf8.DecodeRuneInString(text[offset+len(command):])
+		if isNameSymbol(next) && endsWithNameSymbol(command) {
+			continue
+		}
+		return command, true
+	}
+	return "", false
+}
+
+// normalizeChars replaces each Unicode symbol in the table with the characters of its
+// normalized form, each at the position of the symbol, so that positions reported by the
+// lexer still refer to the original text.  A symbol normalized to a name, such as a Greek
+// letter, is only replaced if it is not part of a larger name, and a symbol normalized to a
+// command, such as `∀`, is separated from a name that follows it so that `∀x` is normalized
+// to `\forall x`.  Stropped names are left as is.
+func normalizeChars(chars []ast.Char, unicodeTable *UnicodeTable) []ast.Char {
+	result := make([]ast.Char, 0, len(chars))
+	inQuotes := false
+	for i, c := range chars {
+		if c.Symbol == '"' {
+			inQuotes = !inQuotes
+		}
+		ascii, ok := unicodeTable.ToAscii(c.Symbol)
+		if !ok || inQuotes || (isNameReplacement(ascii) && isInsideName(chars, i)) {
+			result = append(result, c)
+			continue
+		}
+		for _, symbol := range ascii {
+			result = append(result, ast.Char{
+				Symbol:   symbol,
+				Position: c.Position,
+			})
+		}
+		if isCommandForm(ascii) && endsWithNameSymbol(ascii) &&
+			i+1 < len(chars) && isNameSymbol(chars[i+1].Symbol) {
+			result = append(result, ast.Char{
+				Symbol:   ' ',
+				Position: c.Position,
+			})
+		}
+	}
+	return result
+}
+
+func isCommandForm(ascii string) bool {
+	return strings.HasPrefix(ascii, "\\")
+}
+
+func endsWithNameSymbol(ascii string) bool {
+	last, _ := utf8.DecodeLastRuneInString(ascii)
+	return isNameSymbol(last)
+}
+
+func isNameReplacement(ascii string) bool {
+	first, _ := utf8.DecodeRuneInString(ascii)
+	return isNameSymbol(first)
+}
+
+func isInsideName(chars []ast.Char, index int) bool {
+	if index > 0 && isNameSymbol(chars[index-1].Symbol) {
+		return true
+	}
+	// a trailing number is allowed so that α1 is normalized to alpha1
+	next := index + 1
+	return next < len(chars) && isNameSymbol(chars[next].Symbol) &&
+		!(chars[next].Symbol >= '0' && chars[next].Symbol <= '9')
+}
+
+type unicodeEntry struct {
+	symbol rune
+	ascii  string
+}
+
+var defaultUnicodeEntries = []unicodeEntry{
+	{'≤', "<="},
+	{'≥', ">="},
+	{'≠', "!="},
+	{'→', "->"},
+	{'←', "<-"},
+	{'↦', "|->"},
+	{'⇒', "=>"},
+	{'⇔', "<=>"},
+	{'∀', "\\forall"},
+	{'∃', "\\exists"},
+	{'∈', "\\.in./"},
+	{'⊆', "\\.subset.eq./"},
+	{'∘', "\\.circ./"},
+	{'⊗', "\\.otimes./"},
+	{'α', "alpha"},
+	{'β', "beta"},
+	{'γ', "gamma"},
+	{'δ', "delta"},
+	{'ε', "epsilon"},
+	{'ζ', "zeta"},
+	{'η', "eta"},
+	{'θ', "theta"},
+	{'ι', "iota"},
+	{'κ', "kappa"},
+	{'λ', "lambda"},
+	{'μ', "mu"},
+	{'ν', "nu"},
+	{'ξ', "xi"},
+	{'ο', "omicron"},
+	{'π', "pi"},
+	{'ρ', "rho"},
+	{'σ', "sigma"},
+	{'τ', "tau"},
+	{'υ', "upsilon"},
+	{'φ', "phi"},
+	{'χ', "chi"},
+	{'ψ', "psi"},
+	{'ω', "omega"},
+	{'Γ', "Gamma"},
+	{'Δ', "Delta"},
+	{'Θ', "Theta"},
+	{'Λ', "Lambda"},
+	{'Ξ', "Xi"},
+	{'Π', "Pi"},
+	{'Σ', "Sigma"},
+	{'Υ', "Upsilon"},
+	{'Φ', "Phi"},
+	{'Ψ', "Psi"},
+	{'Ω', "Omega"},
+}
+
+// defaultUnicodeAliases are only normalized when lexed since the symbols look like the
+// ASCII text and so writing them in place of the text would only make the text harder
+// to search.
+var defaultUnicodeAliases = []unicodeEntry{
+	{'≔', ":="},
+	{'−', "-"},
+	{'∗', "*"},
+	{'…', "..."},
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package formulation
+
+import (
+	"fmt"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"mathlingua/internal/mlglib"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnicodeLexer(t *testing.T) {
+	tracker := frontend.NewDiagnosticTracker()
+	lexer := NewLexer("/some/path", `x ≤ y f ↦ α1 x ∈ A ∀x αβ "α"`, tracker, nil)
+
+	actual := "\n"
+	for lexer.HasNext() {
+		next := lexer.Next()
+		actual += fmt.Sprintf("%s %s %d\n", next.Text, next.Type, next.Position.Offset)
+	}
+
+	expected := `
+x Name 0
+<= Operator 2
+y Name 6
+f Name 8
+|-> BarRightDashArrow 10
+alpha1 Name 14
+x Name 18
+\ BackSlash 20
+. Dot 20
+in Name 20
+. Dot 20
+/ Slash 20
+A Name 24
+\ BackSlash 26
+forall Name 26
+x Name 29
+αβ Name 31
+"α" Name 36
+`
+	assert.Equal(t, 0, len(tracker.Diagnostics()))
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnicodeExpression(t *testing.T) {
+	runExpressionTest(t, "x ≤ y", "x <= y")
+	runExpressionTest(t, "f(α) ≠ 0", "f(alpha) != 0")
+	runExpressionTest(t, "A ⊆ B", "A \\.subset.eq./ B")
+	runExpressionTest(t, "x ∈ f ∘ g", "x \\.in./ f \\.circ./ g")
+}
+
+func TestToAsciiText(t *testing.T) {
+	assert.Equal(t, "x <= y \\.in./ A", ToAsciiText("x ≤ y ∈ A", nil))
+	assert.Equal(t, "f(x) |-> alpha_1", ToAsciiText("f(x) ↦ α_1", nil))
+	assert.Equal(t, "xα", ToAsciiText("xα", nil))
+	assert.Equal(t, "\\forall x", ToAsciiText("∀x", nil))
+}
+
+func TestToUnicodeText(t *testing.T) {
+	assert.Equal(t, "x ≤ y", ToUnicodeText("x <= y", nil))
+	assert.Equal(t, "f(x) ↦ α_1", ToUnicodeText("f(x) |-> alpha_1", nil))
+	assert.Equal(t, "alphabet ⇔ x2", ToUnicodeText("alphabet <=> x2", nil))
+	assert.Equal(t, "α1 + \\beta", ToUnicodeText("alpha1 + \\beta", nil))
+	assert.Equal(t, "x ∈ A ⊆ B", ToUnicodeText("x \\.in./ A \\.subset.eq./ B", nil))
+	assert.Equal(t, "∀ x \\forallx", ToUnicodeText("\\forall x \\forallx", nil))
+}
+
+func TestUnicodeAliasesAreOnlyNormalized(t *testing.T) {
+	assert.Equal(t, "x := y - z * w ...", ToAsciiText("x ≔ y − z ∗ w …", nil))
+	assert.Equal(t, "x := y - z * w ...", ToUnicodeText("x := y - z * w ...", nil))
+	assert.Equal(t, "x ≤ y - z", ToUnicodeText("x <= y - z", nil))
+}
+
+func TestCustomUnicodeTable(t *testing.T) {
+	table := DefaultUnicodeTable()
+	table.Add('∈', "\\.element.of./")
+
+	tracker := frontend.NewDiagnosticTracker()
+	node, ok := ParseExpression(
+		"/some/path", "x ∈ A", ast.Position{}, tracker, mlglib.NewKeyGenerator(), nil, table)
+	assert.True(t, ok)
+	assert.Equal(t, "x \\.element.of./ A", node.ToCode(ast.NoOp))
+	assert.Equal(t, "x \\.element.of./ A", ToAsciiText("x ∈ A", table))
+	assert.Equal(t, "x ∈ A", ToUnicodeText("x \\.element.of./ A", table))
+
+	// the table is only used where it is given
+	assert.Equal(t, "x \\.in./ A", ToAsciiText("x ∈ A", nil))
+}
@@ -21,23 +21,41 @@ import (
 	"mathlingua/internal/ast"
 	"mathlingua/internal/frontend"
 	"mathlingua/internal/mlglib"
+	"strings"
 	"unicode"
 )
 
 func NewLexer(text string, path ast.Path, tracker *frontend.DiagnosticTracker) *frontend.Lexer {
+	lexer, _ := NewLexerWithComments(text, path, tracker)
+	return lexer
+}
+
+// NewLexerWithComments is the same as NewLexer except it also returns the `--` comments
+// in the text as Comment tokens, which would otherwise be discarded.
+func NewLexerWithComments(
+	text string,
+	path ast.Path,
+	tracker *frontend.DiagnosticTracker,
+) (*frontend.Lexer, []ast.Token) {
 	// ensure the text ends with enough newlines so that it
 	// terminates any sections and groups.  This makes parsing
 	// easier to implement.
-	return frontend.NewLexer(getTokens(text+"\n\n\n", path, tracker))
+	tokens, comments := getTokens(text+"\n\n\n", path, tracker)
+	return frontend.NewLexer(tokens), comments
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func getTokens(text string, path ast.Path, tracker *frontend.DiagnosticTracker) []ast.Token {
+func getTokens(
+	text string,
+	path ast.Path,
+	tracker *frontend.DiagnosticTracker,
+) ([]ast.Token, []ast.Token) {
 	chars := frontend.GetChars(text)
 	i := 0
 
 	tokens := make([]ast.Token, 0)
+	comments := make([]ast.Token, 0)
 
 	appendToken := func(token ast.Token) {
 		tokens = append(tokens, token)
@@ -48,6 +66,7 @@ func getTokens(text string, path ast.Path, tracker *frontend.DiagnosticTracker)
 			Path:     path,
 			Type:     frontend.Error,
 			Origin:   frontend.Phase1LexerOrigin,
+			Code:     frontend.SyntaxErrorCode,
 			Message:  message,
 			Position: position,
 		})
@@ -79,9 +98,17 @@ func getTokens(text string, path ast.Path, tracker *frontend.DiagnosticTracker)
 		// treat the comment as if it doesn't exist
 		// where the comment continues until the end of the line
 		for i+1 < len(chars) && chars[i].Symbol == '-' && chars[i+1].Symbol == '-' {
+			start := chars[i].Position
+			text := strings.Builder{}
 			for i < len(chars) && chars[i].Symbol != '\n' {
+				text.WriteRune(chars[i].Symbol)
 				i++
 			}
+			comments = append(comments, ast.Token{
+				Type:     ast.Comment,
+				Text:     text.String(),
+				Position: start,
+			})
 
 			// if the comment ends with a newline also absorb that
 			if i < len(chars) && chars[i].Symbol == '\n' {
@@ -383,5 +410,5 @@ func getTokens(text string, path ast.Path, tracker *frontend.DiagnosticTracker)
 		}
 	}
 
-	return tokens
+	return tokens, comments
 }
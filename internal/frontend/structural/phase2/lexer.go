@@ -99,6 +99,7 @@ func getTokens(
 					Path:     path,
 					Type:     frontend.Error,
 					Origin:   frontend.Phase2LexerOrigin,
+					Code:     frontend.SyntaxErrorCode,
 					Message:  fmt.Sprintf("Expected an even indent but found %d", numSpaces),
 					Position: cur.Position,
 				})
@@ -154,6 +154,7 @@ func getTokens(
 			Path:     path,
 			Type:     frontend.Error,
 			Origin:   frontend.Phase3LexerOrigin,
+			Code:     frontend.SyntaxErrorCode,
 			Message:  message,
 			Position: position,
 		})
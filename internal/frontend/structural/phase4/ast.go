@@ -269,6 +269,7 @@ func (t *TextBlock) Start() ast.Position {
 type Document struct {
 	Type     NodeType
 	Nodes    []TopLevelNodeKind
+	Comments []ast.Token
 	MetaData MetaData
 }
 
@@ -52,6 +52,7 @@ func (p *phase4Parser) appendDiagnostic(message string, position ast.Position) {
 		Path:     p.path,
 		Type:     frontend.Error,
 		Origin:   frontend.Phase4ParserOrigin,
+		Code:     frontend.SyntaxErrorCode,
 		Message:  message,
 		Position: position,
 	})
@@ -62,9 +63,10 @@ func (p *phase4Parser) has(tokenType ast.TokenType) bool {
 }
 
 func (p *phase4Parser) skipAheadPast(end ast.TokenType, unterminatedMessage string) {
-	for p.lexer.HasNext() && !p.has(end) {
+	if p.lexer.HasNext() && !p.has(end) {
 		next := p.lexer.Next()
 		p.appendDiagnostic(fmt.Sprintf("Unexpected text '%s'", next.Text), next.Position)
+		p.skipToRecoveryPoint(next, end)
 	}
 
 	if p.has(end) {
@@ -74,6 +76,50 @@ func (p *phase4Parser) skipAheadPast(end ast.TokenType, unterminatedMessage stri
 	}
 }
 
+// skipToRecoveryPoint is called after the unexpected token `skipped` has been consumed and
+// skips tokens until the next token is one of the given stop tokens or ends the enclosing
+// group, section, or argument.  Any groups, sections, or arguments started while skipping
+// are skipped entirely so that parsing resumes at the same level.
+func (p *phase4Parser) skipToRecoveryPoint(skipped ast.Token, stops ...ast.TokenType) {
+	depth := 0
+	if isBeginToken(skipped.Type) {
+		depth = 1
+	}
+	for p.lexer.HasNext() {
+		next := p.lexer.Peek()
+		if depth == 0 {
+			for _, stop := range stops {
+				if next.Type == stop {
+					return
+				}
+			}
+			if isEndToken(next.Type) {
+				return
+			}
+		}
+		if isBeginToken(next.Type) {
+			depth++
+		} else if isEndToken(next.Type) {
+			depth--
+		}
+		p.lexer.Next()
+	}
+}
+
+func isBeginToken(tokenType ast.TokenType) bool {
+	return tokenType == ast.BeginGroup ||
+		tokenType == ast.BeginSection ||
+		tokenType == ast.BeginInlineArgument ||
+		tokenType == ast.BeginDotSpaceArgument
+}
+
+func isEndToken(tokenType ast.TokenType) bool {
+	return tokenType == ast.EndGroup ||
+		tokenType == ast.EndSection ||
+		tokenType == ast.EndInlineArgument ||
+		tokenType == ast.EndDotSpaceArgument
+}
+
 func (p *phase4Parser) document() Document {
 	start := p.lexer.Position()
 	nodes := make([]TopLevelNodeKind, 0)
@@ -103,9 +149,15 @@ func (p *phase4Parser) document() Document {
 				},
 			})
 		} else {
-			// skip the unknown token
+			// skip to the next top-level item
 			next := p.lexer.Next()
 			p.appendDiagnostic("Unexpected text", next.Position)
+			p.skipToRecoveryPoint(next, ast.Id, ast.BeginGroup, ast.TextBlock)
+			// there is no enclosing group, section, or argument at the
+			// top-level and so unmatched end tokens are skipped
+			for p.lexer.HasNext() && isEndToken(p.lexer.Peek().Type) {
+				p.lexer.Next()
+			}
 		}
 	}
 	return Document{
@@ -129,8 +181,10 @@ func (p *phase4Parser) group(id *string) (Group, bool) {
 		if section, ok := p.section(); ok {
 			sections = append(sections, section)
 		} else {
+			// skip to the next section
 			next := p.lexer.Next()
 			p.appendDiagnostic("Expected a section", next.Position)
+			p.skipToRecoveryPoint(next, ast.BeginSection)
 		}
 	}
 
@@ -165,9 +219,11 @@ func (p *phase4Parser) section() (Section, bool) {
 		if arg, ok := p.argument(); ok {
 			args = append(args, arg)
 		} else {
+			// skip to the next argument
 			next := p.lexer.Next()
 			p.appendDiagnostic(
 				fmt.Sprintf("Expected an argument but found '%s'", next.Text), next.Position)
+			p.skipToRecoveryPoint(next, ast.BeginInlineArgument, ast.BeginDotSpaceArgument)
 		}
 	}
 
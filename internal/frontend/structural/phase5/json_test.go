@@ -0,0 +1,81 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package phase5
+
+import (
+	"encoding/json"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/structural/phase1"
+	"mathlingua/internal/frontend/structural/phase2"
+	"mathlingua/internal/frontend/structural/phase3"
+	"mathlingua/internal/frontend/structural/phase4"
+	"mathlingua/internal/mlglib"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJsonRoundTrip(t *testing.T) {
+	inputTextData, err := os.ReadFile(path.Join("..", "..", "..", "..", "testdata", "structural.math"))
+	assert.Nil(t, err)
+
+	tracker := frontend.NewDiagnosticTracker()
+	lexer1 := phase1.NewLexer(string(inputTextData), "", tracker)
+	lexer2 := phase2.NewLexer(lexer1, "", tracker)
+	lexer3 := phase3.NewLexer(lexer2, "", tracker)
+	phase4Doc := phase4.Parse(lexer3, "", tracker)
+	doc, ok := Parse(phase4Doc, "", tracker, mlglib.NewKeyGenerator(), nil, nil)
+	assert.True(t, ok)
+
+	data, err := json.Marshal(doc)
+	assert.Nil(t, err)
+	var actualDoc ast.Document
+	assert.Nil(t, json.Unmarshal(data, &actualDoc))
+	assert.Equal(t, doc, actualDoc)
+
+	data, err = json.Marshal(phase4Doc)
+	assert.Nil(t, err)
+	var actualPhase4Doc phase4.Document
+	assert.Nil(t, json.Unmarshal(data, &actualPhase4Doc))
+	assert.Equal(t, phase4Doc, actualPhase4Doc)
+}
+
+func TestJsonKind(t *testing.T) {
+	data, err := json.Marshal([]ast.FormulationNodeKind{&ast.NameForm{Text: "x"}, nil})
+	assert.Nil(t, err)
+
+	var raw []map[string]any
+	assert.Nil(t, json.Unmarshal(data, &raw))
+	assert.Equal(t, "NameForm", raw[0]["Kind"])
+
+	node, err := ast.UnmarshalFormulationNodeKind([]byte(`{"Kind": "NameForm", "Text": "x"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, &ast.NameForm{Text: "x"}, node)
+
+	node, err = ast.UnmarshalFormulationNodeKind([]byte("null"))
+	assert.Nil(t, err)
+	assert.Nil(t, node)
+
+	_, err = ast.UnmarshalFormulationNodeKind([]byte(`{"Text": "x"}`))
+	assert.NotNil(t, err)
+
+	_, err = ast.UnmarshalClauseKind([]byte(`{"Kind": "NameForm"}`))
+	assert.NotNil(t, err)
+}
@@ -23,6 +23,7 @@ import (
 	"mathlingua/internal/frontend/formulation"
 	"mathlingua/internal/frontend/structural/phase4"
 	"mathlingua/internal/mlglib"
+	"strconv"
 )
 
 func Parse(
@@ -30,11 +31,15 @@ func Parse(
 	path ast.Path,
 	tracker *frontend.DiagnosticTracker,
 	keyGen *mlglib.KeyGenerator,
+	operators *formulation.OperatorTable,
+	unicodeTable *formulation.UnicodeTable,
 ) (ast.Document, bool) {
 	p := parser{
-		path:    path,
-		tracker: tracker,
-		keyGen:  keyGen,
+		path:         path,
+		tracker:      tracker,
+		keyGen:       keyGen,
+		operators:    operators,
+		unicodeTable: unicodeTable,
 	}
 	return p.toDocument(doc)
 }
@@ -42,9 +47,11 @@ func Parse(
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type parser struct {
-	path    ast.Path
-	tracker *frontend.DiagnosticTracker
-	keyGen  *mlglib.KeyGenerator
+	path         ast.Path
+	tracker      *frontend.DiagnosticTracker
+	keyGen       *mlglib.KeyGenerator
+	operators    *formulation.OperatorTable
+	unicodeTable *formulation.UnicodeTable
 }
 
 ///////////////////////////////////////// let ////////////////////////////////////////////////////
@@ -573,12 +580,22 @@ func (p *parser) toSymbolWrittenGroup(group phase4.Group) (ast.SymbolWrittenGrou
 	if sect, ok := sections[ast.LowerWrittenName]; ok {
 		written = p.toWrittenSection(sect)
 	}
+	var precedence *ast.PrecedenceSection
+	if sect, ok := sections[ast.LowerPrecedenceName]; ok {
+		precedence = p.toPrecedenceSection(sect)
+	}
+	var associativity *ast.AssociativitySection
+	if sect, ok := sections[ast.LowerAssociativityName]; ok {
+		associativity = p.toAssociativitySection(sect)
+	}
 	return ast.SymbolWrittenGroup{
 		Label:          label,
 		Symbol:         symbol,
 		Tracks:         tracks,
 		Replaces:       replaces,
 		Written:        written,
+		Precedence:     precedence,
+		Associativity:  associativity,
 		CommonMetaData: toCommonMetaData(group.MetaData),
 	}, true
 }
@@ -611,6 +628,33 @@ func (p *parser) toWrittenSection(section phase4.Section) *ast.WrittenSection {
 	}
 }
 
+func (p *parser) toPrecedenceSection(section phase4.Section) *ast.PrecedenceSection {
+	precedence := p.exactlyOneTextItem(section)
+	if _, err := strconv.Atoi(precedence.RawText); err != nil {
+		p.tracker.Append(p.newError(
+			fmt.Sprintf("Expected the precedence to be an integer but found '%s'", precedence.RawText),
+			section.MetaData.Start))
+	}
+	return &ast.PrecedenceSection{
+		Precedence:     precedence,
+		CommonMetaData: toCommonMetaData(section.MetaData),
+	}
+}
+
+func (p *parser) toAssociativitySection(section phase4.Section) *ast.AssociativitySection {
+	associativity := p.exactlyOneTextItem(section)
+	if associativity.RawText != "left" && associativity.RawText != "right" {
+		p.tracker.Append(p.newError(
+			fmt.Sprintf("Expected the associativity to be 'left' or 'right' but found '%s'",
+				associativity.RawText),
+			section.MetaData.Start))
+	}
+	return &ast.AssociativitySection{
+		Associativity:  associativity,
+		CommonMetaData: toCommonMetaData(section.MetaData),
+	}
+}
+
 func (p *parser) toEncodingGroup(group phase4.Group) (ast.EncodingGroup, bool) {
 	if !startsWithSections(group, ast.LowerEncodingName) {
 		return ast.EncodingGroup{}, false
@@ -1064,6 +1108,17 @@ func (p *parser) toMetaIdSection(section phase4.Section) *ast.MetaIdSection {
 	}
 }
 
+func (p *parser) toViewableSection(section phase4.Section) *ast.ViewableSection {
+	viewable := p.exactlyOneTextItem(section)
+	if viewable.RawText != "true" && viewable.RawText != "false" {
+		p.tracker.Append(p.newError("Expected \"true\" or \"false\"", section.MetaData.Start))
+	}
+	return &ast.ViewableSection{
+		Viewable:       viewable,
+		CommonMetaData: toCommonMetaData(section.MetaData),
+	}
+}
+
 ////////////////////////////////////// describes ///////////////////////////////////////////////////
 
 func (p *parser) toDescribesGroup(group phase4.Group) (ast.DescribesGroup, bool) {
@@ -1125,6 +1180,10 @@ func (p *parser) toDescribesGroup(group phase4.Group) (ast.DescribesGroup, bool)
 	if sec, ok := sections[ast.UpperAliasesName]; ok {
 		aliases = p.toAliasesSection(sec)
 	}
+	var viewable *ast.ViewableSection
+	if sec, ok := sections[ast.UpperViewableName]; ok {
+		viewable = p.toViewableSection(sec)
+	}
 	var metaId *ast.MetaIdSection
 	if sec, ok := sections[ast.UpperIdName]; ok {
 		metaId = p.toMetaIdSection(sec)
@@ -1144,6 +1203,7 @@ func (p *parser) toDescribesGroup(group phase4.Group) (ast.DescribesGroup, bool)
 		Documented:     documented,
 		References:     references,
 		Aliases:        aliases,
+		Viewable:       viewable,
 		MetaId:         metaId,
 		CommonMetaData: toCommonMetaData(group.MetaData),
 	}, true
@@ -1245,6 +1305,10 @@ func (p *parser) toDefinesGroup(group phase4.Group) (ast.DefinesGroup, bool) {
 	if sec, ok := sections[ast.UpperAliasesName]; ok {
 		aliases = p.toAliasesSection(sec)
 	}
+	var viewable *ast.ViewableSection
+	if sec, ok := sections[ast.UpperViewableName]; ok {
+		viewable = p.toViewableSection(sec)
+	}
 	var metaId *ast.MetaIdSection
 	if sec, ok := sections[ast.UpperIdName]; ok {
 		metaId = p.toMetaIdSection(sec)
@@ -1264,6 +1328,7 @@ func (p *parser) toDefinesGroup(group phase4.Group) (ast.DefinesGroup, bool) {
 		Documented:     documented,
 		References:     references,
 		Aliases:        aliases,
+		Viewable:       viewable,
 		MetaId:         metaId,
 		CommonMetaData: toCommonMetaData(group.MetaData),
 	}, true
@@ -1329,6 +1394,10 @@ func (p *parser) toCapturesGroup(group phase4.Group) (ast.CapturesGroup, bool) {
 	if sec, ok := sections[ast.UpperReferencesName]; ok {
 		references = p.toReferencesSection(sec)
 	}
+	var viewable *ast.ViewableSection
+	if sec, ok := sections[ast.UpperViewableName]; ok {
+		viewable = p.toViewableSection(sec)
+	}
 	var metaId *ast.MetaIdSection
 	if sec, ok := sections[ast.UpperIdName]; ok {
 		metaId = p.toMetaIdSection(sec)
@@ -1339,6 +1408,7 @@ func (p *parser) toCapturesGroup(group phase4.Group) (ast.CapturesGroup, bool) {
 		Justified:      justified,
 		Documented:     documented,
 		References:     references,
+		Viewable:       viewable,
 		MetaId:         metaId,
 		CommonMetaData: toCommonMetaData(group.MetaData),
 	}, true
@@ -1400,6 +1470,10 @@ func (p *parser) toStatesGroup(group phase4.Group) (ast.StatesGroup, bool) {
 	if sec, ok := sections[ast.UpperAliasesName]; ok {
 		aliases = p.toAliasesSection(sec)
 	}
+	var viewable *ast.ViewableSection
+	if sec, ok := sections[ast.UpperViewableName]; ok {
+		viewable = p.toViewableSection(sec)
+	}
 	var metaId *ast.MetaIdSection
 	if sec, ok := sections[ast.UpperIdName]; ok {
 		metaId = p.toMetaIdSection(sec)
@@ -1416,6 +1490,7 @@ func (p *parser) toStatesGroup(group phase4.Group) (ast.StatesGroup, bool) {
 		Documented:     documented,
 		References:     references,
 		Aliases:        aliases,
+		Viewable:       viewable,
 		MetaId:         metaId,
 		CommonMetaData: toCommonMetaData(group.MetaData),
 	}, true
@@ -1489,6 +1564,10 @@ func (p *parser) toAxiomGroup(group phase4.Group) (ast.AxiomGroup, bool) {
 	if sec, ok := sections[ast.UpperAliasesName]; ok {
 		aliases = p.toAliasesSection(sec)
 	}
+	var viewable *ast.ViewableSection
+	if sec, ok := sections[ast.UpperViewableName]; ok {
+		viewable = p.toViewableSection(sec)
+	}
 	var metaId *ast.MetaIdSection
 	if sec, ok := sections[ast.UpperIdName]; ok {
 		metaId = p.toMetaIdSection(sec)
@@ -1507,6 +1586,7 @@ func (p *parser) toAxiomGroup(group phase4.Group) (ast.AxiomGroup, bool) {
 		Documented:     documented,
 		References:     references,
 		Aliases:        aliases,
+		Viewable:       viewable,
 		MetaId:         metaId,
 		CommonMetaData: toCommonMetaData(group.MetaData),
 	}, true
@@ -1594,6 +1674,10 @@ func (p *parser) toConjectureGroup(group phase4.Group) (ast.ConjectureGroup, boo
 	if sec, ok := sections[ast.UpperAliasesName]; ok {
 		aliases = p.toAliasesSection(sec)
 	}
+	var viewable *ast.ViewableSection
+	if sec, ok := sections[ast.UpperViewableName]; ok {
+		viewable = p.toViewableSection(sec)
+	}
 	var metaId *ast.MetaIdSection
 	if sec, ok := sections[ast.UpperIdName]; ok {
 		metaId = p.toMetaIdSection(sec)
@@ -1612,6 +1696,7 @@ func (p *parser) toConjectureGroup(group phase4.Group) (ast.ConjectureGroup, boo
 		Documented:     documented,
 		References:     references,
 		Aliases:        aliases,
+		Viewable:       viewable,
 		MetaId:         metaId,
 		CommonMetaData: toCommonMetaData(group.MetaData),
 	}, true
@@ -1682,6 +1767,10 @@ func (p *parser) toTheoremGroup(group phase4.Group) (ast.TheoremGroup, bool) {
 	if sec, ok := sections[ast.UpperAliasesName]; ok {
 		aliases = p.toAliasesSection(sec)
 	}
+	var viewable *ast.ViewableSection
+	if sec, ok := sections[ast.UpperViewableName]; ok {
+		viewable = p.toViewableSection(sec)
+	}
 	var metaId *ast.MetaIdSection
 	if sec, ok := sections[ast.UpperIdName]; ok {
 		metaId = p.toMetaIdSection(sec)
@@ -1701,6 +1790,7 @@ func (p *parser) toTheoremGroup(group phase4.Group) (ast.TheoremGroup, bool) {
 		Documented:     documented,
 		References:     references,
 		Aliases:        aliases,
+		Viewable:       viewable,
 		MetaId:         metaId,
 		CommonMetaData: toCommonMetaData(group.MetaData),
 	}, true
@@ -1775,6 +1865,10 @@ func (p *parser) toCorollaryGroup(group phase4.Group) (ast.CorollaryGroup, bool)
 	if sec, ok := sections[ast.UpperAliasesName]; ok {
 		aliases = p.toAliasesSection(sec)
 	}
+	var viewable *ast.ViewableSection
+	if sec, ok := sections[ast.UpperViewableName]; ok {
+		viewable = p.toViewableSection(sec)
+	}
 	var metaId *ast.MetaIdSection
 	if sec, ok := sections[ast.UpperIdName]; ok {
 		metaId = p.toMetaIdSection(sec)
@@ -1794,6 +1888,7 @@ func (p *parser) toCorollaryGroup(group phase4.Group) (ast.CorollaryGroup, bool)
 		Documented:     documented,
 		References:     references,
 		Aliases:        aliases,
+		Viewable:       viewable,
 		MetaId:         metaId,
 		CommonMetaData: toCommonMetaData(group.MetaData),
 	}, true
@@ -1868,6 +1963,10 @@ func (p *parser) toLemmaGroup(group phase4.Group) (ast.LemmaGroup, bool) {
 	if sec, ok := sections[ast.UpperAliasesName]; ok {
 		aliases = p.toAliasesSection(sec)
 	}
+	var viewable *ast.ViewableSection
+	if sec, ok := sections[ast.UpperViewableName]; ok {
+		viewable = p.toViewableSection(sec)
+	}
 	var metaId *ast.MetaIdSection
 	if sec, ok := sections[ast.UpperIdName]; ok {
 		metaId = p.toMetaIdSection(sec)
@@ -1887,6 +1986,7 @@ func (p *parser) toLemmaGroup(group phase4.Group) (ast.LemmaGroup, bool) {
 		Documented:     documented,
 		References:     references,
 		Aliases:        aliases,
+		Viewable:       viewable,
 		MetaId:         metaId,
 		CommonMetaData: toCommonMetaData(group.MetaData),
 	}, true
@@ -2268,6 +2368,116 @@ func (p *parser) toResourceGroup(group phase4.Group) (ast.ResourceGroup, bool) {
 	}, true
 }
 
+func (p *parser) toTopicGroup(group phase4.Group) (ast.TopicGroup, bool) {
+	if !startsWithSections(group, ast.UpperTopicName) {
+		return ast.TopicGroup{}, false
+	}
+
+	id := p.getStringId(group, true)
+	sections, ok := IdentifySections(p.path, group.Sections, p.tracker, ast.TopicSections...)
+	if !ok || id == nil {
+		return ast.TopicGroup{}, false
+	}
+
+	var documented *ast.DocumentedSection
+	if sec, ok := sections[ast.UpperDocumentedName]; ok {
+		documented = p.toDocumentedSection(sec)
+	}
+	var references *ast.ReferencesSection
+	if sec, ok := sections[ast.UpperReferencesName]; ok {
+		references = p.toReferencesSection(sec)
+	}
+	var viewable *ast.ViewableSection
+	if sec, ok := sections[ast.UpperViewableName]; ok {
+		viewable = p.toViewableSection(sec)
+	}
+	var metaId *ast.MetaIdSection
+	if sec, ok := sections[ast.UpperIdName]; ok {
+		metaId = p.toMetaIdSection(sec)
+	}
+
+	return ast.TopicGroup{
+		Id:             *id,
+		Topic:          *p.toTopicSection(sections[ast.UpperTopicName]),
+		Content:        *p.toTopicContentSection(sections[ast.LowerContentName]),
+		Members:        *p.toTopicMembersSection(sections[ast.LowerMembersName]),
+		Documented:     documented,
+		References:     references,
+		Viewable:       viewable,
+		MetaId:         metaId,
+		CommonMetaData: toCommonMetaData(group.MetaData),
+	}, true
+}
+
+func (p *parser) toTopicSection(section phase4.Section) *ast.TopicSection {
+	return &ast.TopicSection{
+		Name:           p.exactlyOneTextItem(section),
+		CommonMetaData: toCommonMetaData(section.MetaData),
+	}
+}
+
+func (p *parser) toTopicContentSection(section phase4.Section) *ast.TopicContentSection {
+	return &ast.TopicContentSection{
+		Content:        p.exactlyOneTextItem(section),
+		CommonMetaData: toCommonMetaData(section.MetaData),
+	}
+}
+
+func (p *parser) toTopicMembersSection(section phase4.Section) *ast.TopicMembersSection {
+	return &ast.TopicMembersSection{
+		Members:        p.oneOrMoreSignatures(section),
+		CommonMetaData: toCommonMetaData(section.MetaData),
+	}
+}
+
+func (p *parser) toNoteGroup(group phase4.Group) (ast.NoteGroup, bool) {
+	if !startsWithSections(group, ast.UpperNoteName) {
+		return ast.NoteGroup{}, false
+	}
+
+	sections, ok := IdentifySections(p.path, group.Sections, p.tracker, ast.NoteSections...)
+	if !ok {
+		return ast.NoteGroup{}, false
+	}
+
+	var on *ast.NoteOnSection
+	if sec, ok := sections[ast.LowerOnName]; ok {
+		on = p.toNoteOnSection(sec)
+	}
+	var viewable *ast.ViewableSection
+	if sec, ok := sections[ast.UpperViewableName]; ok {
+		viewable = p.toViewableSection(sec)
+	}
+	var metaId *ast.MetaIdSection
+	if sec, ok := sections[ast.UpperIdName]; ok {
+		metaId = p.toMetaIdSection(sec)
+	}
+
+	return ast.NoteGroup{
+		Note:           *p.toNoteSection(sections[ast.UpperNoteName]),
+		On:             on,
+		Viewable:       viewable,
+		MetaId:         metaId,
+		CommonMetaData: toCommonMetaData(group.MetaData),
+	}, true
+}
+
+func (p *parser) toNoteSection(section phase4.Section) *ast.NoteSection {
+	return &ast.NoteSection{
+		Note:           p.exactlyOneTextItem(section),
+		CommonMetaData: toCommonMetaData(section.MetaData),
+	}
+}
+
+func (p *parser) toNoteOnSection(section phase4.Section) *ast.NoteOnSection {
+	var def ast.Formulation[ast.FormulationNodeKind] = ast.Formulation[ast.FormulationNodeKind]{}
+	return &ast.NoteOnSection{
+		On: exactlyOne(p, p.toSignatureFormulations(section.Args), def,
+			section.MetaData.Start, p.tracker),
+		CommonMetaData: toCommonMetaData(section.MetaData),
+	}
+}
+
 func (p *parser) toResourceSection(section phase4.Section) *ast.ResourceSection {
 	return &ast.ResourceSection{
 		Items:          p.oneOrMoreResourceKinds(section),
@@ -2679,6 +2889,7 @@ func (p *parser) toDescriptionSection(section phase4.Section) *ast.DescriptionSe
 ///////////////////////////////////////// top level items //////////////////////////////////////////
 
 func (p *parser) toTopLevelItemKind(item phase4.TopLevelNodeKind) (ast.TopLevelItemKind, bool) {
+	countBefore := p.tracker.Length()
 	switch item := item.(type) {
 	case *phase4.TextBlock:
 		return p.toTextBlockItem(*item), true
@@ -2707,7 +2918,17 @@ func (p *parser) toTopLevelItemKind(item phase4.TopLevelNodeKind) (ast.TopLevelI
 			return &grp, ok
 		} else if grp, ok := p.toResourceGroup(*item); ok {
 			return &grp, ok
+		} else if grp, ok := p.toTopicGroup(*item); ok {
+			return &grp, ok
+		} else if grp, ok := p.toNoteGroup(*item); ok {
+			return &grp, ok
 		}
+		// record where the group is so the items after
+		// it in the document can still be processed
+		if p.tracker.Length() == countBefore {
+			p.tracker.Append(p.newError("Invalid top level item", item.Start()))
+		}
+		return p.toErrorGroup(*item), true
 	}
 	p.tracker.Append(p.newError("Invalid top level item", item.Start()))
 	return nil, false
@@ -2715,6 +2936,17 @@ func (p *parser) toTopLevelItemKind(item phase4.TopLevelNodeKind) (ast.TopLevelI
 
 /////////////////////////////////////// document ///////////////////////////////////////////////////
 
+func (p *parser) toErrorGroup(group phase4.Group) *ast.ErrorGroup {
+	names := make([]string, 0, len(group.Sections))
+	for _, sect := range group.Sections {
+		names = append(names, sect.Name)
+	}
+	return &ast.ErrorGroup{
+		SectionNames:   names,
+		CommonMetaData: toCommonMetaData(group.MetaData),
+	}
+}
+
 func (p *parser) toDocument(root phase4.Document) (ast.Document, bool) {
 	countBefore := p.tracker.Length()
 	items := make([]ast.TopLevelItemKind, 0)
@@ -2743,7 +2975,8 @@ func (p *parser) toDocument(root phase4.Document) (ast.Document, bool) {
 ///////////////////////////////////////////// id ///////////////////////////////////////////////////
 
 func (p *parser) toIdItem(text string, position ast.Position) *ast.IdItem {
-	if node, ok := formulation.ParseId(p.path, text, position, p.tracker, p.keyGen); ok {
+	if node, ok := formulation.ParseId(
+		p.path, text, position, p.tracker, p.keyGen, p.operators, p.unicodeTable); ok {
 		return &ast.IdItem{
 			RawText: text,
 			Root:    node,
@@ -2796,7 +3029,7 @@ func (p *parser) maybeToFormulation(
 	switch data := arg.Arg.(type) {
 	case *phase4.FormulationArgumentData:
 		if node, ok := formulation.ParseExpression(
-			p.path, data.Text, arg.MetaData.Start, p.tracker, p.keyGen); ok {
+			p.path, data.Text, arg.MetaData.Start, p.tracker, p.keyGen, p.operators, p.unicodeTable); ok {
 			return ast.Formulation[ast.FormulationNodeKind]{
 				RawText:        data.Text,
 				Root:           node,
@@ -2817,6 +3050,7 @@ func (p *parser) toFormulation(arg phase4.Argument) ast.Formulation[ast.Formulat
 }
 
 func (p *parser) toClause(arg phase4.Argument) ast.ClauseKind {
+	countBefore := p.tracker.Length()
 	switch data := arg.Arg.(type) {
 	case *phase4.TextArgumentData:
 		return &ast.TextItem{
@@ -2825,7 +3059,7 @@ func (p *parser) toClause(arg phase4.Argument) ast.ClauseKind {
 		}
 	case *phase4.FormulationArgumentData:
 		if node, ok := formulation.ParseExpression(
-			p.path, data.Text, arg.MetaData.Start, p.tracker, p.keyGen); ok {
+			p.path, data.Text, arg.MetaData.Start, p.tracker, p.keyGen, p.operators, p.unicodeTable); ok {
 			return &ast.Formulation[ast.FormulationNodeKind]{
 				RawText:        data.Text,
 				Root:           node,
@@ -2866,6 +3100,10 @@ func (p *parser) toClause(arg phase4.Argument) ast.ClauseKind {
 			return &grp
 		} else if grp, ok := p.toMatchingGroup(*data); ok {
 			return &grp
+		} else if p.tracker.Length() != countBefore {
+			// the group was recognized but is malformed and
+			// the problem has already been reported
+			return p.toErrorGroup(*data)
 		}
 	}
 
@@ -2880,7 +3118,7 @@ func (p *parser) toSpec(arg phase4.Argument) ast.Spec {
 	switch data := arg.Arg.(type) {
 	case *phase4.FormulationArgumentData:
 		if node, ok := formulation.ParseExpression(
-			p.path, data.Text, arg.MetaData.Start, p.tracker, p.keyGen); ok {
+			p.path, data.Text, arg.MetaData.Start, p.tracker, p.keyGen, p.operators, p.unicodeTable); ok {
 			return ast.Spec{
 				RawText:        data.Text,
 				Root:           node,
@@ -2901,7 +3139,7 @@ func (p *parser) toAlias(arg phase4.Argument) ast.Alias {
 	switch data := arg.Arg.(type) {
 	case *phase4.FormulationArgumentData:
 		if node, ok := formulation.ParseExpression(
-			p.path, data.Text, arg.MetaData.Start, p.tracker, p.keyGen); ok {
+			p.path, data.Text, arg.MetaData.Start, p.tracker, p.keyGen, p.operators, p.unicodeTable); ok {
 			return ast.Alias{
 				RawText:        data.Text,
 				Root:           node,
@@ -2918,7 +3156,7 @@ func (p *parser) toTarget(arg phase4.Argument) ast.Target {
 	switch data := arg.Arg.(type) {
 	case *phase4.ArgumentTextArgumentData:
 		if node, ok := formulation.ParseForm(p.path, data.Text, arg.MetaData.Start,
-			p.tracker, p.keyGen); ok {
+			p.tracker, p.keyGen, p.operators, p.unicodeTable); ok {
 			return ast.Target{
 				RawText:        data.Text,
 				Root:           node,
@@ -3012,6 +3250,35 @@ func (p *parser) toBiographySection(section phase4.Section) *ast.BiographySectio
 
 ///////////////////////////////////// argument lists ///////////////////////////////////////////////
 
+func (p *parser) toSignatureFormulation(
+	arg phase4.Argument,
+) ast.Formulation[ast.FormulationNodeKind] {
+	if data, ok := arg.Arg.(*phase4.FormulationArgumentData); ok {
+		if node, ok := formulation.ParseSignature(
+			p.path, data.Text, arg.MetaData.Start, p.tracker, p.keyGen, p.unicodeTable); ok {
+			return ast.Formulation[ast.FormulationNodeKind]{
+				RawText:        data.Text,
+				Root:           &node,
+				Label:          data.Label,
+				CommonMetaData: toCommonMetaData(data.MetaData),
+			}
+		}
+		return ast.Formulation[ast.FormulationNodeKind]{}
+	}
+	p.tracker.Append(p.newError("Expected a signature", arg.MetaData.Start))
+	return ast.Formulation[ast.FormulationNodeKind]{}
+}
+
+func (p *parser) toSignatureFormulations(
+	args []phase4.Argument,
+) []ast.Formulation[ast.FormulationNodeKind] {
+	result := make([]ast.Formulation[ast.FormulationNodeKind], 0)
+	for _, arg := range args {
+		result = append(result, p.toSignatureFormulation(arg))
+	}
+	return result
+}
+
 func (p *parser) toFormulations(args []phase4.Argument) []ast.Formulation[ast.FormulationNodeKind] {
 	result := make([]ast.Formulation[ast.FormulationNodeKind], 0)
 	for _, arg := range args {
@@ -4499,6 +4766,11 @@ func (p *parser) oneOrMoreFormulation(
 	return oneOrMore(p, p.toFormulations(section.Args), section.MetaData.Start, p.tracker)
 }
 
+func (p *parser) oneOrMoreSignatures(
+	section phase4.Section) []ast.Formulation[ast.FormulationNodeKind] {
+	return oneOrMore(p, p.toSignatureFormulations(section.Args), section.MetaData.Start, p.tracker)
+}
+
 func (p *parser) oneOrMoreSpecs(section phase4.Section) []ast.Spec {
 	return oneOrMore(p, p.toSpecs(section.Args), section.MetaData.Start, p.tracker)
 }
@@ -4595,6 +4867,7 @@ func (p *parser) newError(message string, position ast.Position) frontend.Diagno
 		Path:     p.path,
 		Type:     frontend.Error,
 		Origin:   frontend.Phase5ParserOrigin,
+		Code:     frontend.InvalidStructureCode,
 		Message:  message,
 		Position: position,
 	}
@@ -18,6 +18,7 @@ package phase5
 
 import (
 	"fmt"
+	"mathlingua/internal/ast"
 	"mathlingua/internal/frontend"
 	"mathlingua/internal/frontend/structural/phase1"
 	"mathlingua/internal/frontend/structural/phase2"
@@ -43,7 +44,7 @@ func TestParserSmoke(t *testing.T) {
 	lexer3 := phase3.NewLexer(lexer2, "", tracker)
 
 	root := phase4.Parse(lexer3, "", tracker)
-	_, ok := Parse(root, "", tracker, mlglib.NewKeyGenerator())
+	_, ok := Parse(root, "", tracker, mlglib.NewKeyGenerator(), nil, nil)
 
 	output := ""
 	for _, diag := range tracker.Diagnostics() {
@@ -66,3 +67,36 @@ func TestParserSmoke(t *testing.T) {
 
 	assert.Equal(t, expectedOutput, actualOutput)
 }
+
+func TestParserRecoversFromErrors(t *testing.T) {
+	inputTextData, err := os.ReadFile(
+		path.Join("..", "..", "..", "..", "testdata", "structural_errors.math"))
+	assert.Nil(t, err)
+	inputText := string(inputTextData)
+
+	tracker := frontend.NewDiagnosticTracker()
+
+	lexer1 := phase1.NewLexer(inputText, "", tracker)
+	lexer2 := phase2.NewLexer(lexer1, "", tracker)
+	lexer3 := phase3.NewLexer(lexer2, "", tracker)
+
+	root := phase4.Parse(lexer3, "", tracker)
+	doc, ok := Parse(root, "", tracker, mlglib.NewKeyGenerator(), nil, nil)
+	assert.False(t, ok)
+
+	// each independent error is reported once and the
+	// entries around the errors are still parsed
+	actualOutput := ""
+	for _, diag := range tracker.Diagnostics() {
+		actualOutput += fmt.Sprintf("%s (%d, %d): %s [%s]\n", diag.Type, diag.Position.Row,
+			diag.Position.Column, diag.Message, diag.Origin)
+	}
+	actualOutput += "\n" + ast.StructuralNodeToCode(&doc)
+
+	expectedOutputData, err := os.ReadFile(
+		path.Join("..", "..", "..", "..", "testdata", "structural_errors_expected.txt"))
+	assert.Nil(t, err)
+	expectedOutput := string(expectedOutputData)
+
+	assert.Equal(t, expectedOutput, actualOutput)
+}
@@ -22,6 +22,7 @@ import (
 	"mathlingua/internal/frontend"
 	"mathlingua/internal/frontend/structural/phase4"
 	"mathlingua/internal/mlglib"
+	"sort"
 	"strings"
 )
 
@@ -40,8 +41,19 @@ func IdentifySections(
 		pattern += name + ":"
 	}
 
+	ordered := sections
+	if order, ok := CanonicalSectionOrder(sections, expected...); ok {
+		tracker.Append(NewSectionOrderDiagnostic(path, sections, order))
+		// the sections are identified in the expected order so that the only problem
+		// reported for the group is the order of its sections
+		ordered = make([]phase4.Section, 0, len(sections))
+		for _, index := range order {
+			ordered = append(ordered, sections[index])
+		}
+	}
+
 	sectionQueue := mlglib.NewQueue[phase4.Section]()
-	for _, section := range sections {
+	for _, section := range ordered {
 		sectionQueue.Push(section)
 	}
 
@@ -86,6 +98,7 @@ func IdentifySections(
 				Type:   frontend.Error,
 				Path:   path,
 				Origin: frontend.Phase5ParserOrigin,
+				Code:   frontend.InvalidStructureCode,
 				Message: "For pattern:\n\n" +
 					pattern +
 					"\n\nExpected '" +
@@ -105,6 +118,7 @@ func IdentifySections(
 			Type:   frontend.Error,
 			Path:   path,
 			Origin: frontend.Phase5ParserOrigin,
+			Code:   frontend.InvalidStructureCode,
 			Message: "For pattern:\n\n" + pattern +
 				"\n\nUnexpected section '" + peek.Name + "'",
 			Position: peek.MetaData.Start,
@@ -132,6 +146,7 @@ func IdentifySections(
 			Type:   frontend.Error,
 			Path:   path,
 			Origin: frontend.Phase5ParserOrigin,
+			Code:   frontend.InvalidStructureCode,
 			Message: "For pattern:\n\n" + pattern +
 				"\n\nExpected a section '" + nextExpected + "'",
 			Position: start,
@@ -141,3 +156,87 @@ func IdentifySections(
 
 	return result, true
 }
+
+// CanonicalSectionOrder returns the indices of the given sections in the order of the
+// expected sections if every section is expected, no section is repeated, and the sections
+// are not already in that order.
+func CanonicalSectionOrder(sections []phase4.Section, expected ...string) ([]int, bool) {
+	indices := make([]int, len(sections))
+	seen := make(map[string]bool)
+	for i, section := range sections {
+		index := GetSectionIndex(expected, section.Name)
+		if index < 0 || seen[section.Name] {
+			return nil, false
+		}
+		seen[section.Name] = true
+		indices[i] = index
+	}
+
+	order := make([]int, len(sections))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return indices[order[i]] < indices[order[j]]
+	})
+	for i, index := range order {
+		if i != index {
+			return order, true
+		}
+	}
+	return nil, false
+}
+
+// NewSectionOrderDiagnostic returns the diagnostic describing the first of the given
+// sections that is out of order where the order is the one given by CanonicalSectionOrder.
+func NewSectionOrderDiagnostic(
+	path ast.Path,
+	sections []phase4.Section,
+	order []int,
+) frontend.Diagnostic {
+	// the rank of each section in the expected order
+	ranks := make([]int, len(sections))
+	for rank, index := range order {
+		ranks[index] = rank
+	}
+
+	misplaced := sections[0]
+	before := sections[0]
+	found := false
+	for i := 1; i < len(sections) && !found; i++ {
+		for j := 0; j < i; j++ {
+			if ranks[j] > ranks[i] {
+				misplaced = sections[i]
+				before = sections[j]
+				found = true
+				break
+			}
+		}
+	}
+
+	expected := ""
+	for _, index := range order {
+		expected += "\n" + sections[index].Name + ":"
+	}
+
+	return frontend.Diagnostic{
+		Type:   frontend.Error,
+		Path:   path,
+		Origin: frontend.Phase5ParserOrigin,
+		Code:   frontend.SectionOrderCode,
+		Message: "Section '" + misplaced.Name + "' must come before '" + before.Name +
+			"'.  Expected the sections in the order:\n" + expected,
+		Position: misplaced.MetaData.Start,
+	}
+}
+
+// GetSectionIndex returns the index of the section with the given name in the given
+// expected sections, where optional sections end with ?, or -1 if it is not expected.
+func GetSectionIndex(expected []string, name string) int {
+	for i, e := range expected {
+		if strings.TrimSuffix(e, "?") == name {
+			return i
+		}
+	}
+	return -1
+}
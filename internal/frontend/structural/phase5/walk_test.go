@@ -0,0 +1,162 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package phase5
+
+import (
+	"fmt"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/structural/phase1"
+	"mathlingua/internal/frontend/structural/phase2"
+	"mathlingua/internal/frontend/structural/phase3"
+	"mathlingua/internal/frontend/structural/phase4"
+	"mathlingua/internal/mlglib"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalk(t *testing.T) {
+	doc := parseForWalk(t, `Theorem:
+given: f(x)
+then: 'x'
+Id: "1"
+`)
+
+	events := make([]string, 0)
+	ast.Walk(&doc, ast.Visitor{
+		Enter: func(node ast.MlgNodeKind, path []ast.PathItem) bool {
+			events = append(events, fmt.Sprintf("enter %T %s", node, formatPath(path)))
+			// the children of the formulation are skipped
+			_, ok := node.(*ast.Formulation[ast.FormulationNodeKind])
+			return !ok
+		},
+		Exit: func(node ast.MlgNodeKind, path []ast.PathItem) {
+			events = append(events, fmt.Sprintf("exit %T", node))
+		},
+	})
+	assert.Equal(t, []string{
+		"enter *ast.Document ",
+		"enter *ast.TheoremGroup Items[0]",
+		"enter *ast.Target Items[0]/Given.Given[0]",
+		"enter *ast.FunctionForm Items[0]/Given.Given[0]/Root",
+		"enter *ast.NameForm Items[0]/Given.Given[0]/Root/Target",
+		"exit *ast.NameForm",
+		"enter *ast.NameForm Items[0]/Given.Given[0]/Root/Params[0]",
+		"exit *ast.NameForm",
+		"exit *ast.FunctionForm",
+		"exit *ast.Target",
+		"enter *ast.Formulation[mathlingua/internal/ast.FormulationNodeKind] " +
+			"Items[0]/Then.Clauses[0]",
+		"exit *ast.Formulation[mathlingua/internal/ast.FormulationNodeKind]",
+		"enter *ast.TextItem Items[0]/MetaId.Id",
+		"exit *ast.TextItem",
+		"exit *ast.TheoremGroup",
+		"exit *ast.Document",
+	}, events)
+}
+
+func TestTransform(t *testing.T) {
+	doc := parseForWalk(t, `Theorem:
+given: f(x)
+then: 'x'
+Id: "1"
+`)
+
+	result := ast.Transform(&doc, func(node ast.MlgNodeKind) ast.MlgNodeKind {
+		if name, ok := node.(*ast.NameForm); ok {
+			return &ast.NameForm{Text: strings.ToUpper(name.Text)}
+		}
+		return node
+	})
+	assert.Equal(t, &doc, result)
+	theorem := doc.Items[0].(*ast.TheoremGroup)
+	assert.Equal(t, "F(X)", theorem.Given.Given[0].Root.ToCode(ast.NoOp))
+
+	// a node stored by value can only be replaced with a node of the same type
+	assert.Panics(t, func() {
+		ast.Transform(&doc, func(node ast.MlgNodeKind) ast.MlgNodeKind {
+			if _, ok := node.(*ast.Target); ok {
+				return &ast.NameForm{Text: "x"}
+			}
+			return node
+		})
+	})
+}
+
+func TestClone(t *testing.T) {
+	inputTextData, err := os.ReadFile(path.Join("..", "..", "..", "..", "testdata", "structural.math"))
+	assert.Nil(t, err)
+	doc := parseForWalk(t, string(inputTextData))
+	expected := parseForWalk(t, string(inputTextData))
+
+	clone := ast.Clone(&doc)
+	assert.Equal(t, &doc, clone)
+
+	nodes := make(map[ast.MlgNodeKind]bool)
+	ast.Walk(&doc, ast.Visitor{
+		Enter: func(node ast.MlgNodeKind, path []ast.PathItem) bool {
+			nodes[node] = true
+			return true
+		},
+	})
+	shared := 0
+	ast.Walk(clone, ast.Visitor{
+		Enter: func(node ast.MlgNodeKind, path []ast.PathItem) bool {
+			if nodes[node] {
+				shared++
+			}
+			return true
+		},
+	})
+	assert.Equal(t, 0, shared)
+
+	ast.Transform(clone, func(node ast.MlgNodeKind) ast.MlgNodeKind {
+		if name, ok := node.(*ast.NameForm); ok {
+			name.Text = strings.ToUpper(name.Text)
+		}
+		return node
+	})
+	assert.NotEqual(t, &doc, clone)
+	assert.Equal(t, expected, doc)
+}
+
+func parseForWalk(t *testing.T, text string) ast.Document {
+	tracker := frontend.NewDiagnosticTracker()
+	lexer1 := phase1.NewLexer(text, "", tracker)
+	lexer2 := phase2.NewLexer(lexer1, "", tracker)
+	lexer3 := phase3.NewLexer(lexer2, "", tracker)
+	phase4Doc := phase4.Parse(lexer3, "", tracker)
+	doc, ok := Parse(phase4Doc, "", tracker, mlglib.NewKeyGenerator(), nil, nil)
+	assert.True(t, ok)
+	return doc
+}
+
+func formatPath(path []ast.PathItem) string {
+	parts := make([]string, 0, len(path))
+	for _, item := range path {
+		if item.Index >= 0 {
+			parts = append(parts, fmt.Sprintf("%s[%d]", item.Field, item.Index))
+		} else {
+			parts = append(parts, item.Field)
+		}
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mlg
+
+import (
+	"encoding/json"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/frontend"
+	"os"
+)
+
+type DiagnosticCounts struct {
+	Errors   int
+	Warnings int
+}
+
+// CheckSummary is the machine-readable summary of the diagnostics found by `mlg check`.
+type CheckSummary struct {
+	FilesProcessed int
+	Errors         int
+	Warnings       int
+	ByOrigin       map[frontend.DiagnosticOrigin]DiagnosticCounts
+	ByCode         map[frontend.DiagnosticCode]DiagnosticCounts
+	ByFile         map[ast.Path]DiagnosticCounts
+}
+
+func GetCheckSummary(filesProcessed int, diagnostics []frontend.Diagnostic) CheckSummary {
+	summary := CheckSummary{
+		FilesProcessed: filesProcessed,
+		ByOrigin:       make(map[frontend.DiagnosticOrigin]DiagnosticCounts),
+		ByCode:         make(map[frontend.DiagnosticCode]DiagnosticCounts),
+		ByFile:         make(map[ast.Path]DiagnosticCounts),
+	}
+	for _, diag := range diagnostics {
+		if diag.Type == frontend.Error {
+			summary.Errors++
+		} else if diag.Type == frontend.Warning {
+			summary.Warnings++
+		}
+		summary.ByOrigin[diag.Origin] = summary.ByOrigin[diag.Origin].add(diag)
+		summary.ByCode[diag.Code] = summary.ByCode[diag.Code].add(diag)
+		summary.ByFile[diag.Path] = summary.ByFile[diag.Path].add(diag)
+	}
+	return summary
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (c DiagnosticCounts) add(diag frontend.Diagnostic) DiagnosticCounts {
+	if diag.Type == frontend.Error {
+		c.Errors++
+	} else if diag.Type == frontend.Warning {
+		c.Warnings++
+	}
+	return c
+}
+
+func writeCheckSummary(path string, summary CheckSummary) error {
+	data, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
@@ -17,12 +17,17 @@
 package mlg
 
 import (
+	"archive/zip"
 	"encoding/json"
 	"fmt"
+	"io/fs"
+	"mathlingua/internal/ast"
 	"mathlingua/internal/backend"
 	"mathlingua/internal/config"
 	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/formulation"
 	"mathlingua/internal/logger"
+	"sort"
 )
 
 func NewMlg(logger *logger.Logger) *Mlg {
@@ -35,55 +40,323 @@ type Mlg struct {
 	logger  *logger.Logger
 	tracker *frontend.DiagnosticTracker
 	conf    config.MlgConfig
+	// the normalizations of Unicode symbols in formulations described by conf
+	unicodeTable *formulation.UnicodeTable
 }
 
-func (m *Mlg) Check(paths []string, showJson bool, debug bool) {
-	workspace, diagnostics := backend.NewWorkspaceFromPaths(paths, m.tracker)
+// CheckOptions describes how `mlg check` processes and reports diagnostics.
+type CheckOptions struct {
+	ShowJson bool
+	Debug    bool
+	// if non-empty, only diagnostics not recorded in this baseline file are reported
+	BaselinePath string
+	// if non-empty, all diagnostics are recorded in this baseline file instead of reported
+	WriteBaselinePath string
+	// the check fails if there are more warnings than this, or -1 for no limit
+	MaxWarnings int
+	// if non-empty, a summary of the diagnostics is written to this file as JSON
+	SummaryJsonPath string
+	// if non-empty, the paths are checked in this zip archive instead of on disk
+	ArchivePath string
+}
+
+// The exit codes returned by Check.
+const (
+	CheckPassedExitCode          = 0
+	CheckFoundErrorsExitCode     = 1
+	CheckTooManyWarningsExitCode = 2
+	CheckInternalFailureExitCode = 3
+)
+
+// Check checks the Mathlingua files at the given paths and returns the exit code the
+// `mlg check` process should exit with.
+func (m *Mlg) Check(paths []string, options CheckOptions) (exitCode int) {
+	defer func() {
+		if r := recover(); r != nil {
+			m.logger.Failure(fmt.Sprintf("Internal failure: %v", r))
+			exitCode = CheckInternalFailureExitCode
+		}
+	}()
+
+	var workspace *backend.Workspace
+	var diagnostics []frontend.Diagnostic
+	if options.ArchivePath != "" {
+		archive, err := zip.OpenReader(options.ArchivePath)
+		if err != nil {
+			m.logger.Failure(fmt.Sprintf("Failed to open archive %s: %s",
+				options.ArchivePath, err))
+			return CheckInternalFailureExitCode
+		}
+		defer archive.Close()
+		fsys := getArchiveRoot(archive)
+		// the mlg.conf in the archive is used instead of the one in the working directory
+		conf := config.LoadMlgConfigFS(fsys, m.tracker)
+		workspace, diagnostics = backend.NewWorkspaceFromFS(fsys, paths, m.tracker,
+			backend.GetUnicodeTable(*conf))
+	} else {
+		workspace, diagnostics = backend.NewWorkspaceFromPaths(paths, m.tracker, m.unicodeTable)
+	}
 
 	checkResult := workspace.Check()
 	diagnostics = append(diagnostics, checkResult.Diagnostics...)
 
-	numErrors := 0
-	numWarnings := 0
-	for _, diag := range diagnostics {
-		if diag.Type == frontend.Error {
-			numErrors++
-		} else if diag.Type == frontend.Warning {
-			numWarnings++
+	if options.WriteBaselinePath != "" {
+		baseline := workspace.GetBaseline(diagnostics)
+		if err := backend.WriteBaseline(options.WriteBaselinePath, baseline); err != nil {
+			m.logger.Failure(fmt.Sprintf("Failed to write baseline %s: %s",
+				options.WriteBaselinePath, err))
+			return CheckInternalFailureExitCode
 		}
+		m.logger.Success(fmt.Sprintf("Wrote %d %s to baseline %s",
+			len(baseline.Entries), pluralize(len(baseline.Entries), "diagnostic", "diagnostics"),
+			options.WriteBaselinePath))
+		return CheckPassedExitCode
+	}
+
+	if options.BaselinePath != "" {
+		baseline, err := backend.LoadBaseline(options.BaselinePath)
+		if err != nil {
+			m.logger.Failure(fmt.Sprintf("Failed to read baseline %s: %s",
+				options.BaselinePath, err))
+			return CheckInternalFailureExitCode
+		}
+		diagnostics = workspace.FilterBaseline(baseline, diagnostics)
 	}
 
 	numFilesProcessed := workspace.DocumentCount()
+	summary := GetCheckSummary(numFilesProcessed, diagnostics)
 
-	if showJson {
+	exitCode = CheckPassedExitCode
+	if summary.Errors > 0 {
+		exitCode = CheckFoundErrorsExitCode
+	} else if options.MaxWarnings >= 0 && summary.Warnings > options.MaxWarnings {
+		exitCode = CheckTooManyWarningsExitCode
+	}
+
+	if options.SummaryJsonPath != "" {
+		if err := writeCheckSummary(options.SummaryJsonPath, summary); err != nil {
+			m.logger.Failure(fmt.Sprintf("Failed to write summary %s: %s",
+				options.SummaryJsonPath, err))
+			return CheckInternalFailureExitCode
+		}
+	}
+
+	if options.ShowJson {
 		m.printAsJson(backend.CheckResult{
 			Diagnostics: diagnostics,
 		})
-		return
+		return exitCode
 	}
 
-	m.printCheckStats(numErrors, numWarnings, numFilesProcessed, debug, diagnostics)
+	m.printCheckStats(summary, options, diagnostics)
+	return exitCode
 }
 
 func (m *Mlg) View(port int) {
 	backend.StartServer(port, m.conf)
 }
 
+// Fmt formats the Mathlingua files at the given paths in place and returns the exit code
+// the `mlg fmt` process should exit with.
+func (m *Mlg) Fmt(paths []string, options backend.FormatOptions) int {
+	options.UnicodeTable = m.unicodeTable
+	changed, diagnostics := backend.FormatFiles(paths, options)
+	exitCode := CheckPassedExitCode
+	for _, diag := range diagnostics {
+		if diag.Type == frontend.Error {
+			exitCode = CheckFoundErrorsExitCode
+			m.logger.Error(fmt.Sprintf("%s\n%s", diag.Path, diag.Message))
+		} else {
+			m.logger.Warning(fmt.Sprintf("%s\n%s", diag.Path, diag.Message))
+		}
+	}
+	for _, path := range changed {
+		m.logger.Log(string(path))
+	}
+	m.logger.Success(fmt.Sprintf("Formatted %d %s", len(changed),
+		pluralize(len(changed), "file", "files")))
+	return exitCode
+}
+
+// Fix applies the code actions available for the Mathlingua files at the given paths in
+// place and returns the exit code the `mlg fix` process should exit with.  Only the safe
+// actions are applied unless includeUnsafe is true.
+func (m *Mlg) Fix(paths []string, includeUnsafe bool) int {
+	changed, diagnostics := backend.FixFiles(paths, includeUnsafe, m.unicodeTable)
+	exitCode := CheckPassedExitCode
+	for _, diag := range diagnostics {
+		if diag.Type == frontend.Error {
+			exitCode = CheckFoundErrorsExitCode
+			m.logger.Error(fmt.Sprintf("%s\n%s", diag.Path, diag.Message))
+		} else {
+			m.logger.Warning(fmt.Sprintf("%s\n%s", diag.Path, diag.Message))
+		}
+	}
+	for _, path := range changed {
+		m.logger.Log(string(path))
+	}
+	m.logger.Success(fmt.Sprintf("Fixed %d %s", len(changed),
+		pluralize(len(changed), "file", "files")))
+	return exitCode
+}
+
+// Find prints the formulations in the workspace that match the given query and returns the
+// exit code the `mlg find` process should exit with.
+func (m *Mlg) Find(query string, showJson bool) int {
+	workspace, _ := backend.NewWorkspaceFromPaths([]string{"."}, m.tracker, m.unicodeTable)
+	results, err := workspace.Find(query)
+	if err != nil {
+		m.logger.Error(fmt.Sprintf("Invalid query: %s", err))
+		return CheckFoundErrorsExitCode
+	}
+
+	if showJson {
+		if data, err := json.MarshalIndent(backend.FindResponse{
+			Results: results,
+		}, "", "  "); err != nil {
+			m.logger.Error(err.Error())
+		} else {
+			m.logger.Log(string(data))
+		}
+		return CheckPassedExitCode
+	}
+
+	for _, result := range results {
+		m.logger.Log(fmt.Sprintf("%s (%d, %d) [%s]\n%s", result.Path, result.Position.Row+1,
+			result.Position.Column+1, result.Id, result.Formulation))
+		names := make([]string, 0, len(result.Bindings))
+		for name := range result.Bindings {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			m.logger.Log(fmt.Sprintf("  %s? = %s", name, result.Bindings[name]))
+		}
+		m.logger.Log("")
+	}
+	m.logger.Success(fmt.Sprintf("Found %d %s", len(results),
+		pluralize(len(results), "match", "matches")))
+	return CheckPassedExitCode
+}
+
+// Hierarchy prints the entries the `Describes:` entry with the given signature extends or
+// satisfies as a tree, followed by its children and the entries that specify it, and
+// returns the exit code the `mlg hierarchy` process should exit with.
+func (m *Mlg) Hierarchy(signature string, showJson bool) int {
+	workspace, _ := backend.NewWorkspaceFromPaths([]string{"."}, m.tracker, m.unicodeTable)
+	hierarchy, err := workspace.GetHierarchy(signature)
+	if err != nil {
+		m.logger.Error(err.Error())
+		return CheckFoundErrorsExitCode
+	}
+
+	if showJson {
+		if data, err := json.MarshalIndent(backend.HierarchyResponse{
+			Hierarchy: &hierarchy,
+		}, "", "  "); err != nil {
+			m.logger.Error(err.Error())
+		} else {
+			m.logger.Log(string(data))
+		}
+		return CheckPassedExitCode
+	}
+
+	m.logger.Log(fmt.Sprintf("%s [%s]", hierarchy.Signature, hierarchy.Id))
+	m.logHierarchyParents(workspace.GetHierarchies(), hierarchy, "", map[string]bool{
+		hierarchy.Signature: true,
+	})
+	if len(hierarchy.Children) > 0 {
+		m.logger.Log("\nChildren:")
+		for _, child := range hierarchy.Children {
+			m.logger.Log("  " + child)
+		}
+	}
+	if len(hierarchy.SpecifiedBy) > 0 {
+		m.logger.Log("\nSpecified by:")
+		for _, sig := range hierarchy.SpecifiedBy {
+			m.logger.Log("  " + sig)
+		}
+	}
+	return CheckPassedExitCode
+}
+
+// Unfold prints the code of the entry with the given id or signature with its statements
+// unfolded to the given depth and returns the exit code the `mlg unfold` process should
+// exit with.
+func (m *Mlg) Unfold(idOrSignature string, depth int) int {
+	workspace, _ := backend.NewWorkspaceFromPaths([]string{"."}, m.tracker, m.unicodeTable)
+	code, err := workspace.Unfold(idOrSignature, depth)
+	if err != nil {
+		m.logger.Error(err.Error())
+		return CheckFoundErrorsExitCode
+	}
+	m.logger.Log(code)
+	return CheckPassedExitCode
+}
+
 func (m *Mlg) Version() string {
 	return "v0.22.0"
 }
 
 func (m *Mlg) GetUsages() []string {
-	workspace, _ := backend.NewWorkspaceFromPaths([]string{"."}, m.tracker)
+	workspace, _ := backend.NewWorkspaceFromPaths([]string{"."}, m.tracker, m.unicodeTable)
 	return workspace.GetUsages()
 }
 
+func (m *Mlg) GetCompletions(path ast.Path, position ast.Position) []backend.Completion {
+	workspace, _ := backend.NewWorkspaceFromPaths([]string{"."}, m.tracker, m.unicodeTable)
+	return workspace.Completions(path, position)
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
 func (m *Mlg) initialize(logger *logger.Logger) {
 	m.logger = logger
 	m.tracker = frontend.NewDiagnosticTracker()
 	m.conf = *config.LoadMlgConfig(m.tracker)
+	m.unicodeTable = backend.GetUnicodeTable(m.conf)
+}
+
+// getArchiveRoot returns the directory in the archive that contains the collection, which
+// is the only top-level directory if the archive was created by zipping the directory of
+// the collection, and otherwise is the root of the archive.
+func getArchiveRoot(archive fs.FS) fs.FS {
+	entries, err := fs.ReadDir(archive, ".")
+	if err != nil || len(entries) != 1 || !entries[0].IsDir() {
+		return archive
+	}
+	sub, err := fs.Sub(archive, entries[0].Name())
+	if err != nil {
+		return archive
+	}
+	return sub
+}
+
+// logHierarchyParents logs the parents of the hierarchy, and their parents, as the branches
+// of a tree, where seen contains the signatures on the path to the hierarchy so that
+// circular hierarchies are only followed once.
+func (m *Mlg) logHierarchyParents(
+	hierarchies map[string]backend.Hierarchy,
+	hierarchy backend.Hierarchy,
+	prefix string,
+	seen map[string]bool,
+) {
+	for i, parent := range hierarchy.Parents {
+		branch, indent := "├── ", "│   "
+		if i == len(hierarchy.Parents)-1 {
+			branch, indent = "└── ", "    "
+		}
+		if seen[parent] {
+			m.logger.Log(prefix + branch + parent + " (circular)")
+			continue
+		}
+		m.logger.Log(prefix + branch + parent)
+		if parentHierarchy, ok := hierarchies[parent]; ok {
+			seen[parent] = true
+			m.logHierarchyParents(hierarchies, parentHierarchy, prefix+indent, seen)
+			delete(seen, parent)
+		}
+	}
 }
 
 func (m *Mlg) printAsJson(checkResult backend.CheckResult) {
@@ -95,48 +368,38 @@ func (m *Mlg) printAsJson(checkResult backend.CheckResult) {
 	}
 }
 
-func (m *Mlg) printCheckStats(numErrors int, numWarnings int, numFilesProcessed int,
-	debug bool, diagnostics []frontend.Diagnostic) {
+func (m *Mlg) printCheckStats(summary CheckSummary, options CheckOptions,
+	diagnostics []frontend.Diagnostic) {
 	for index, diag := range diagnostics {
 		if index > 0 {
 			// print a line between each error
 			m.logger.Log("")
 		}
 		debugInfo := ""
-		if debug {
-			debugInfo = fmt.Sprintf(" [%s]", diag.Origin)
+		if diag.Code != "" {
+			debugInfo = fmt.Sprintf(" [%s]", diag.Code)
+		}
+		if options.Debug {
+			debugInfo += fmt.Sprintf(" [%s]", diag.Origin)
 		}
 		if diag.Type == frontend.Error {
-			m.logger.Error(fmt.Sprintf("%s (%d, %d)%s\n%s",
+			m.logger.Error(fmt.Sprintf("%s (%d, %d)%s\n%s%s",
 				diag.Path, diag.Position.Row+1, diag.Position.Column+1,
-				debugInfo, diag.Message))
+				debugInfo, diag.Message, diag.SuggestionsText()))
 		} else {
-			m.logger.Warning(fmt.Sprintf("%s (%d, %d)%s\n%s",
+			m.logger.Warning(fmt.Sprintf("%s (%d, %d)%s\n%s%s",
 				diag.Path, diag.Position.Row+1, diag.Position.Column+1,
-				debugInfo, diag.Message))
+				debugInfo, diag.Message, diag.SuggestionsText()))
 		}
 	}
 
-	var errorText string
-	if numErrors == 1 {
-		errorText = "error"
-	} else {
-		errorText = "errors"
-	}
+	numErrors := summary.Errors
+	numWarnings := summary.Warnings
+	numFilesProcessed := summary.FilesProcessed
 
-	var warningText string
-	if numWarnings == 1 {
-		warningText = "warning"
-	} else {
-		warningText = "warnings"
-	}
-
-	var filesText string
-	if numFilesProcessed == 1 {
-		filesText = "file"
-	} else {
-		filesText = "files"
-	}
+	errorText := pluralize(numErrors, "error", "errors")
+	warningText := pluralize(numWarnings, "warning", "warnings")
+	filesText := pluralize(numFilesProcessed, "file", "files")
 
 	if numErrors > 0 {
 		// if there are errors logged, then log a blank line before
@@ -144,11 +407,24 @@ func (m *Mlg) printCheckStats(numErrors int, numWarnings int, numFilesProcessed
 		m.logger.Log("")
 	}
 
+	tooManyWarnings := options.MaxWarnings >= 0 && numWarnings > options.MaxWarnings
 	if numErrors > 0 {
 		m.logger.Failure(fmt.Sprintf("Processed %d %s and found %d %s and %d %s",
 			numFilesProcessed, filesText, numErrors, errorText, numWarnings, warningText))
+	} else if tooManyWarnings {
+		m.logger.Failure(fmt.Sprintf(
+			"Processed %d %s and found %d %s and %d %s (more than the maximum of %d)",
+			numFilesProcessed, filesText, numErrors, errorText, numWarnings, warningText,
+			options.MaxWarnings))
 	} else {
 		m.logger.Success(fmt.Sprintf("Processed %d %s and found %d %s and %d %s",
 			numFilesProcessed, filesText, numErrors, errorText, numWarnings, warningText))
 	}
 }
+
+func pluralize(count int, singular string, plural string) string {
+	if count == 1 {
+		return singular
+	}
+	return plural
+}
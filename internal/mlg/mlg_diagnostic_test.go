@@ -18,6 +18,8 @@ package mlg
 
 import (
 	"bytes"
+	"encoding/json"
+	"mathlingua/internal/frontend"
 	"mathlingua/internal/logger"
 	"os"
 	"testing"
@@ -38,7 +40,7 @@ Id: "123"
 Defines: y
 ------------------------------------------
 Id: "456"`,
-		ExpectedOutput: `ERROR: test.math (9, 1)
+		ExpectedOutput: `ERROR: test.math (9, 1) [duplicate-signature]
 Duplicate defined signature \:a
 
 FAILURE: Processed 1 file and found 1 error and 0 warnings
@@ -54,10 +56,10 @@ given: x
 then: 'x is \a'
 ------------------------------------------
 Id: "123"`,
-		ExpectedOutput: `ERROR: test.math (4, 13)
+		ExpectedOutput: `ERROR: test.math (4, 13) [unrecognized-signature]
 Unrecognized signature \:a
 
-ERROR: test.math (4, 13)
+ERROR: test.math (4, 13) [missing-written]
 Signature \:a does not have a Documented:called: or Documented:written: section
 
 FAILURE: Processed 1 file and found 2 errors and 0 warnings
@@ -79,7 +81,7 @@ given: x
 then: 'x is \a'
 ------------------------------------------
 Id: "456"`,
-		ExpectedOutput: `ERROR: test.math (10, 13)
+		ExpectedOutput: `ERROR: test.math (10, 13) [missing-written]
 Signature \:a does not have a Documented:called: or Documented:written: section
 
 FAILURE: Processed 1 file and found 1 error and 0 warnings
@@ -145,7 +147,7 @@ given: x
 then: 'x is \a'
 ------------------------------------------
 Id: "456"`,
-		ExpectedOutput: `ERROR: test.math (12, 13)
+		ExpectedOutput: `ERROR: test.math (12, 13) [signature-mismatch]
 Expected a {} argument but found none
 
 FAILURE: Processed 1 file and found 1 error and 0 warnings
@@ -169,7 +171,7 @@ given: x, y, z
 then: 'x is \a{y, z}'
 ------------------------------------------
 Id: "456"`,
-		ExpectedOutput: `ERROR: test.math (12, 13)
+		ExpectedOutput: `ERROR: test.math (12, 13) [signature-mismatch]
 Expected 1 values but found 2: Received: y, z
 
 FAILURE: Processed 1 file and found 1 error and 0 warnings
@@ -235,7 +237,7 @@ given: x
 then: 'x is \a'
 ------------------------------------------
 Id: "456"`,
-		ExpectedOutput: `ERROR: test.math (12, 13)
+		ExpectedOutput: `ERROR: test.math (12, 13) [signature-mismatch]
 Expected 1 values but found 0: Received: 
 
 FAILURE: Processed 1 file and found 1 error and 0 warnings
@@ -259,7 +261,7 @@ given: x, y, z
 then: 'x is \a(y, z)'
 ------------------------------------------
 Id: "456"`,
-		ExpectedOutput: `ERROR: test.math (12, 13)
+		ExpectedOutput: `ERROR: test.math (12, 13) [signature-mismatch]
 Expected 1 values but found 2: Received: y, z
 
 FAILURE: Processed 1 file and found 1 error and 0 warnings
@@ -309,6 +311,705 @@ Id: "456"`,
 	})
 }
 
+func TestDiagnosticSuggestsSimilarSignatures(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+[\set.intersect]
+Defines: X
+Documented:
+. called: "set intersect"
+------------------------------------------
+Id: "123"
+
+
+[\set.union]
+Defines: X
+Documented:
+. called: "set union"
+------------------------------------------
+Id: "456"
+
+
+[\set.meet]
+Defines: X
+Documented:
+. called: "set intersection (meet)"
+------------------------------------------
+Id: "789"
+
+
+Theorem:
+given: x
+then: 'x is \set.intersection'
+------------------------------------------
+Id: "012"`,
+		ExpectedOutput: `ERROR: test.math (28, 13) [unrecognized-signature]
+Unrecognized signature \:set.intersection
+Did you mean: \:set.intersect, \:set.meet
+
+ERROR: test.math (28, 13) [missing-written]
+Signature \:set.intersection does not have a Documented:called: or Documented:written: section
+
+FAILURE: Processed 1 file and found 2 errors and 0 warnings
+`,
+	})
+}
+
+func TestDiagnosticDoesNotSuggestSignaturesForShortSegments(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+[\prime.number]
+Defines: p
+Documented:
+. called: "prime number"
+------------------------------------------
+Id: "123"
+
+
+Theorem:
+given: x, y
+then:
+. 'x is \m'
+. 'y is \num'
+------------------------------------------
+Id: "456"`,
+		ExpectedOutput: `ERROR: test.math (13, 9) [unrecognized-signature]
+Unrecognized signature \:m
+
+ERROR: test.math (14, 9) [unrecognized-signature]
+Unrecognized signature \:num
+
+ERROR: test.math (13, 9) [missing-written]
+Signature \:m does not have a Documented:called: or Documented:written: section
+
+ERROR: test.math (14, 9) [missing-written]
+Signature \:num does not have a Documented:called: or Documented:written: section
+
+FAILURE: Processed 1 file and found 4 errors and 0 warnings
+`,
+	})
+}
+
+func TestDiagnosticConflictingOperatorPrecedence(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+[\power{a}]
+Defines: p
+Provides:
+. symbol: 'x ** y :=> x'
+  precedence: "11"
+  associativity: "right"
+Documented:
+. called: "power"
+------------------------------------------
+Id: "123"
+
+
+[\other.power{a}]
+Defines: q
+Provides:
+. symbol: 'x ** y :=> y'
+  precedence: "11"
+  associativity: "left"
+Documented:
+. called: "other power"
+------------------------------------------
+Id: "456"`,
+		ExpectedOutput: `ERROR: test.math (17, 4) [conflicting-operator]
+The precedence and associativity of '**' conflicts with the declaration at test.math (5, 4)
+
+FAILURE: Processed 1 file and found 1 error and 0 warnings
+`,
+	})
+}
+
+func TestDiagnosticInvalidOperatorPrecedence(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+[\power{a}]
+Defines: p
+Provides:
+. symbol: 'x ** y :=> x'
+  precedence: "high"
+  associativity: "up"
+Documented:
+. called: "power"
+------------------------------------------
+Id: "123"`,
+		ExpectedOutput: `ERROR: test.math (6, 4) [invalid-structure]
+Expected the precedence to be an integer but found 'high'
+
+ERROR: test.math (7, 4) [invalid-structure]
+Expected the associativity to be 'left' or 'right' but found 'up'
+
+FAILURE: Processed 1 file and found 2 errors and 0 warnings
+`,
+	})
+}
+
+func TestDiagnosticAmbiguousOperator(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+[\integer]
+Describes: n
+Provides:
+. symbol: 'x + y :=> x'
+  written: "x? \oplus y?"
+Documented:
+. called: "integer"
+------------------------------------------
+Id: "1"
+
+
+[\matrix]
+Describes: M
+Provides:
+. symbol: 'x + y :=> x'
+  written: "x? \boxplus y?"
+Documented:
+. called: "matrix"
+------------------------------------------
+Id: "2"
+
+
+Theorem:
+given: a, b
+where:
+. 'a, b is \integer'
+. 'a, b is \matrix'
+then: 'a + b'
+------------------------------------------
+Id: "3"`,
+		ExpectedOutput: `ERROR: test.math (29, 8) [ambiguous-operator]
+The operator '+' is ambiguous and could refer to the operator provided by any of \:integer, \:matrix
+
+FAILURE: Processed 1 file and found 1 error and 0 warnings
+`,
+	})
+}
+
+func TestDiagnosticUnresolvedOperator(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+[\integer]
+Describes: n
+Provides:
+. symbol: 'x + y :=> x'
+  written: "x? \oplus y?"
+Documented:
+. called: "integer"
+------------------------------------------
+Id: "1"
+
+
+[\rational]
+Describes: q
+Documented:
+. called: "rational"
+------------------------------------------
+Id: "2"
+
+
+Theorem:
+given: a, b
+where: 'a, b is \rational'
+then: 'a + b'
+------------------------------------------
+Id: "3"`,
+		ExpectedOutput: `ERROR: test.math (24, 8) [unresolved-operator]
+No operator '+' is provided for operands of type \:rational and \:rational
+
+FAILURE: Processed 1 file and found 1 error and 0 warnings
+`,
+	})
+}
+
+func TestNoDiagnosticOperatorResolvedThroughExtends(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+[\integer]
+Describes: n
+Provides:
+. symbol: 'x + y :=> x'
+  written: "x? \oplus y?"
+Documented:
+. called: "integer"
+------------------------------------------
+Id: "1"
+
+
+[\natural]
+Describes: n
+extends: 'n is \integer'
+Documented:
+. called: "natural"
+------------------------------------------
+Id: "2"
+
+
+Theorem:
+given: a, b
+where: 'a, b is \natural'
+then: 'a + b'
+------------------------------------------
+Id: "3"`,
+		ExpectedOutput: `SUCCESS: Processed 1 file and found 0 errors and 0 warnings
+`,
+	})
+}
+
+func TestNoDiagnosticUnicodeFormulation(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+Theorem:
+given: α, β, f
+then:
+. 'α ≤ β'
+. 'f ≠ (x ↦ β)'
+------------------------------------------
+Id: "1"`,
+		ExpectedOutput: `SUCCESS: Processed 1 file and found 0 errors and 0 warnings
+`,
+	})
+}
+
+func TestCircularExtends(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+[\a]
+Describes: x
+extends: 'x is \b'
+Documented:
+. called: "a"
+------------------------------------------
+Id: "1"
+
+
+[\b]
+Describes: y
+extends: 'y is \c'
+Documented:
+. called: "b"
+------------------------------------------
+Id: "2"
+
+
+[\c]
+Describes: z
+extends: 'z is \a'
+Documented:
+. called: "c"
+------------------------------------------
+Id: "3"`,
+		ExpectedOutput: `ERROR: test.math (3, 1) [circular-definition]
+Circular definition:
+\:a (id 1 at test.math (3, 1))
+-> \:b (id 2 at test.math (12, 1))
+-> \:c (id 3 at test.math (21, 1))
+-> \:a (id 1 at test.math (3, 1))
+
+FAILURE: Processed 1 file and found 1 error and 0 warnings
+`,
+	})
+}
+
+func TestCircularMeans(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+[\even]
+Defines: n
+means: 'n is \even'
+Documented:
+. called: "even"
+------------------------------------------
+Id: "1"`,
+		ExpectedOutput: `ERROR: test.math (3, 1) [circular-definition]
+Circular definition:
+\:even (id 1 at test.math (3, 1))
+-> \:even (id 1 at test.math (3, 1))
+
+FAILURE: Processed 1 file and found 1 error and 0 warnings
+`,
+	})
+}
+
+func TestCircularDefinitionReportedOnce(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+[\even]
+Defines: n
+means: 'n is \odd'
+Documented:
+. called: "even"
+------------------------------------------
+Id: "1"
+
+
+[\odd]
+Defines: n
+means:
+. 'n is \even'
+. 'n + 1 is \even'
+Documented:
+. called: "odd"
+------------------------------------------
+Id: "2"`,
+		ExpectedOutput: `ERROR: test.math (3, 1) [circular-definition]
+Circular definition:
+\:even (id 1 at test.math (3, 1))
+-> \:odd (id 2 at test.math (12, 1))
+-> \:even (id 1 at test.math (3, 1))
+
+FAILURE: Processed 1 file and found 1 error and 0 warnings
+`,
+	})
+}
+
+func TestCircularDefinitionsReportsEveryCycle(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+[\a]
+Defines: x
+means:
+. 'x is \b'
+. 'x is \c'
+Documented:
+. called: "a"
+------------------------------------------
+Id: "1"
+
+
+[\b]
+Defines: x
+means: 'x is \c'
+Documented:
+. called: "b"
+------------------------------------------
+Id: "2"
+
+
+[\c]
+Defines: x
+means: 'x is \a'
+Documented:
+. called: "c"
+------------------------------------------
+Id: "3"`,
+		ExpectedOutput: `ERROR: test.math (3, 1) [circular-definition]
+Circular definition:
+\:a (id 1 at test.math (3, 1))
+-> \:b (id 2 at test.math (14, 1))
+-> \:c (id 3 at test.math (23, 1))
+-> \:a (id 1 at test.math (3, 1))
+
+ERROR: test.math (3, 1) [circular-definition]
+Circular definition:
+\:a (id 1 at test.math (3, 1))
+-> \:c (id 3 at test.math (23, 1))
+-> \:a (id 1 at test.math (3, 1))
+
+FAILURE: Processed 1 file and found 2 errors and 0 warnings
+`,
+	})
+}
+
+func TestUnknownTopicMember(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+[\group]
+Describes: G
+Documented:
+. called: "group"
+------------------------------------------
+Id: "1"
+
+
+[group.theory]
+Topic: "Group theory"
+content: "The study of groups."
+members:
+. '\:group'
+. '\:grop'
+------------------------------------------
+Id: "2"`,
+		ExpectedOutput: `ERROR: test.math (15, 4) [unrecognized-signature]
+Unrecognized topic member \:grop
+Did you mean: \:group
+
+FAILURE: Processed 1 file and found 1 error and 0 warnings
+`,
+	})
+}
+
+func TestUnknownNoteTarget(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+Note: "Some note."
+on: '\:grop'
+------------------------------------------
+Id: "1"`,
+		ExpectedOutput: `ERROR: test.math (3, 6) [unrecognized-signature]
+Unrecognized note target \:grop
+
+FAILURE: Processed 1 file and found 1 error and 0 warnings
+`,
+	})
+}
+
+func TestInvalidViewable(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+Theorem:
+then: 'x'
+Viewable: "no"
+------------------------------------------
+Id: "1"`,
+		ExpectedOutput: `ERROR: test.math (4, 2) [invalid-structure]
+Expected "true" or "false"
+
+FAILURE: Processed 1 file and found 1 error and 0 warnings
+`,
+	})
+}
+
+func TestSectionOrder(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+Theorem:
+then: 'x'
+given: x
+------------------------------------------
+Id: "1"`,
+		ExpectedOutput: `ERROR: test.math (4, 2) [section-order]
+Section 'given' must come before 'then'.  Expected the sections in the order:
+
+Theorem:
+given:
+then:
+Id:
+
+FAILURE: Processed 1 file and found 1 error and 0 warnings
+`,
+	})
+}
+
+func TestSuppressDiagnosticOnGroup(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+-- mlg:ignore unrecognized-signature \a is defined elsewhere
+-- mlg:ignore missing-written \a is defined elsewhere
+Theorem:
+given: x
+then: 'x is \a'
+------------------------------------------
+Id: "123"
+
+
+Theorem:
+given: x
+then: 'x is \b'
+------------------------------------------
+Id: "456"`,
+		ExpectedOutput: `ERROR: test.math (13, 13) [unrecognized-signature]
+Unrecognized signature \:b
+
+ERROR: test.math (13, 13) [missing-written]
+Signature \:b does not have a Documented:called: or Documented:written: section
+
+FAILURE: Processed 1 file and found 2 errors and 0 warnings
+`,
+	})
+}
+
+func TestSuppressDiagnosticOnSection(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+Theorem:
+given: x
+-- mlg:ignore unrecognized-signature
+then: 'x is \a'
+------------------------------------------
+Id: "123"`,
+		ExpectedOutput: `ERROR: test.math (5, 13) [missing-written]
+Signature \:a does not have a Documented:called: or Documented:written: section
+
+FAILURE: Processed 1 file and found 1 error and 0 warnings
+`,
+	})
+}
+
+func TestSuppressDiagnosticInFile(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+-- mlg:ignore-file unrecognized-signature
+-- mlg:ignore-file missing-written
+Theorem:
+given: x
+then: 'x is \a'
+------------------------------------------
+Id: "123"
+
+
+Theorem:
+given: x
+then: 'x is \b'
+------------------------------------------
+Id: "456"`,
+		ExpectedOutput: `SUCCESS: Processed 1 file and found 0 errors and 0 warnings
+`,
+	})
+}
+
+func TestUnusedSuppression(t *testing.T) {
+	runTest(t, TestCase{
+		Input: `
+[\a]
+Defines: a
+Documented:
+. called: "a"
+------------------------------------------
+Id: "123"
+
+
+-- mlg:ignore unrecognized-signature
+Theorem:
+given: x
+then: 'x is \a'
+------------------------------------------
+Id: "456"`,
+		ExpectedOutput: `WARNING: test.math (10, 2) [unused-suppression]
+Unused suppression of unrecognized-signature
+SUCCESS: Processed 1 file and found 0 errors and 1 warning
+`,
+	})
+}
+
+func TestBaselineOnlyReportsNewDiagnostics(t *testing.T) {
+	dirName, err := os.MkdirTemp("", "mlg_diagnostic_test")
+	if err != nil {
+		t.FailNow()
+	}
+
+	err = os.Chdir(dirName)
+	if err != nil {
+		t.FailNow()
+	}
+	defer os.RemoveAll(dirName)
+
+	legacy := `
+Theorem:
+given: x
+then: 'x is \a'
+------------------------------------------
+Id: "123"`
+
+	err = os.WriteFile("test.math", []byte(legacy), 0644)
+	if err != nil {
+		t.FailNow()
+	}
+
+	var writeBuffer bytes.Buffer
+	NewMlg(logger.NewLogger(&writeBuffer)).Check([]string{"."}, CheckOptions{
+		MaxWarnings:       -1,
+		WriteBaselinePath: "baseline.json",
+	})
+	assert.Equal(t, "SUCCESS: Wrote 2 diagnostics to baseline baseline.json\n", writeBuffer.String())
+
+	// the legacy entry moves to a different row but is still in the baseline
+	err = os.WriteFile("test.math", []byte(`
+Theorem:
+given: x
+then: 'x is \b'
+------------------------------------------
+Id: "456"
+
+`+legacy), 0644)
+	if err != nil {
+		t.FailNow()
+	}
+
+	var checkBuffer bytes.Buffer
+	NewMlg(logger.NewLogger(&checkBuffer)).Check([]string{"."}, CheckOptions{
+		MaxWarnings:  -1,
+		BaselinePath: "baseline.json",
+	})
+	assert.Equal(t, `ERROR: test.math (4, 13) [unrecognized-signature]
+Unrecognized signature \:b
+
+ERROR: test.math (4, 13) [missing-written]
+Signature \:b does not have a Documented:called: or Documented:written: section
+
+FAILURE: Processed 1 file and found 2 errors and 0 warnings
+`, checkBuffer.String())
+}
+
+func TestCheckExitCodes(t *testing.T) {
+	dirName, err := os.MkdirTemp("", "mlg_diagnostic_test")
+	if err != nil {
+		t.FailNow()
+	}
+
+	err = os.Chdir(dirName)
+	if err != nil {
+		t.FailNow()
+	}
+	defer os.RemoveAll(dirName)
+
+	// an unused suppression results in exactly one warning
+	err = os.WriteFile("test.math", []byte(`
+-- mlg:ignore-file unrecognized-signature
+Theorem:
+then: 'x'
+------------------------------------------
+Id: "123"`), 0644)
+	if err != nil {
+		t.FailNow()
+	}
+
+	var buffer bytes.Buffer
+	mlg := NewMlg(logger.NewLogger(&buffer))
+	assert.Equal(t, CheckPassedExitCode, mlg.Check([]string{"."}, CheckOptions{MaxWarnings: -1}))
+	assert.Equal(t, CheckPassedExitCode, mlg.Check([]string{"."}, CheckOptions{MaxWarnings: 1}))
+
+	buffer.Reset()
+	assert.Equal(t, CheckTooManyWarningsExitCode, NewMlg(logger.NewLogger(&buffer)).Check(
+		[]string{"."}, CheckOptions{
+			MaxWarnings:     0,
+			SummaryJsonPath: "summary.json",
+		}))
+	assert.Equal(t, `WARNING: test.math (2, 2) [unused-suppression]
+Unused suppression of unrecognized-signature
+FAILURE: Processed 1 file and found 0 errors and 1 warning (more than the maximum of 0)
+`, buffer.String())
+
+	data, err := os.ReadFile("summary.json")
+	assert.Nil(t, err)
+	var summary CheckSummary
+	assert.Nil(t, json.Unmarshal(data, &summary))
+	assert.Equal(t, 1, summary.Warnings)
+	assert.Equal(t, DiagnosticCounts{Warnings: 1},
+		summary.ByCode[frontend.UnusedSuppressionCode])
+	assert.Equal(t, DiagnosticCounts{Warnings: 1}, summary.ByFile["test.math"])
+
+	err = os.WriteFile("test.math", []byte(`
+Theorem:
+then: 'x is \a'
+------------------------------------------
+Id: "123"`), 0644)
+	if err != nil {
+		t.FailNow()
+	}
+	assert.Equal(t, CheckFoundErrorsExitCode, NewMlg(logger.NewLogger(&buffer)).Check(
+		[]string{"."}, CheckOptions{MaxWarnings: -1}))
+
+	// a baseline that cannot be read is not reported as errors found in the content
+	assert.Equal(t, CheckInternalFailureExitCode, NewMlg(logger.NewLogger(&buffer)).Check(
+		[]string{"."}, CheckOptions{
+			MaxWarnings:  -1,
+			BaselinePath: "missing.json",
+		}))
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type TestCase struct {
@@ -337,7 +1038,7 @@ func runTest(t *testing.T, testCase TestCase) {
 
 	logger := logger.NewLogger(&buffer)
 	mlg := NewMlg(logger)
-	mlg.Check([]string{"."}, false, false)
+	mlg.Check([]string{"."}, CheckOptions{MaxWarnings: -1})
 
 	assert.Equal(t, testCase.ExpectedOutput, buffer.String())
 }
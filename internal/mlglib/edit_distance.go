@@ -0,0 +1,60 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mlglib
+
+// EditDistance returns the Levenshtein distance between the given strings, i.e. the
+// minimum number of single character insertions, deletions, and substitutions needed
+// to change one string into the other.
+func EditDistance(a string, b string) int {
+	return SliceEditDistance([]rune(a), []rune(b),
+		func(item rune) int {
+			return 1
+		},
+		func(x rune, y rune) int {
+			if x == y {
+				return 0
+			}
+			return 1
+		})
+}
+
+// SliceEditDistance returns the minimum cost needed to change one slice into the other where
+// the cost of inserting or deleting an item is given by indelCost and the cost of substituting
+// one item for another is given by substitutionCost.
+func SliceEditDistance[T any](
+	a []T,
+	b []T,
+	indelCost func(item T) int,
+	substitutionCost func(x T, y T) int,
+) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := 1; j <= len(b); j++ {
+		prev[j] = prev[j-1] + indelCost(b[j-1])
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = prev[0] + indelCost(a[i-1])
+		for j := 1; j <= len(b); j++ {
+			cur[j] = min(
+				prev[j]+indelCost(a[i-1]),
+				cur[j-1]+indelCost(b[j-1]),
+				prev[j-1]+substitutionCost(a[i-1], b[j-1]))
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mlglib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 0, EditDistance("", ""))
+	assert.Equal(t, 3, EditDistance("abc", ""))
+	assert.Equal(t, 3, EditDistance("", "abc"))
+	assert.Equal(t, 0, EditDistance("intersection", "intersection"))
+	assert.Equal(t, 3, EditDistance("intersection", "intersect"))
+	assert.Equal(t, 3, EditDistance("kitten", "sitting"))
+	assert.Equal(t, 1, EditDistance("αβ", "αγ"))
+}
+
+func TestSliceEditDistance(t *testing.T) {
+	length := func(item string) int {
+		return len(item)
+	}
+	assert.Equal(t, 3, SliceEditDistance(
+		[]string{"set", "intersection"}, []string{"set", "intersect"}, length, EditDistance))
+	assert.Equal(t, 3, SliceEditDistance(
+		[]string{"set", "intersection"}, []string{"intersection"}, length, EditDistance))
+}
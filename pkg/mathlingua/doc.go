@@ -0,0 +1,40 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mathlingua is the supported Go API for parsing and checking Mathlingua
+// collections.  Tools such as indexers and bots should use it instead of the packages under
+// internal/ or the output of `mlg check --json`.
+//
+// The types in this package are independent of the parser's internal representations.
+// Their fields are only added to, and never renamed or removed, within a major Version.
+//
+// Positions are zero based and columns count characters.
+//
+// The module path is `mathlingua`, which is not a domain based import path, and so the
+// package cannot be fetched with `go get`.  A module that uses it needs a require and a
+// replace directive pointing at a local checkout of this repository:
+//
+//	require mathlingua v0.0.0
+//	replace mathlingua => ../mathlingua
+//
+//	ws := mathlingua.NewWorkspace([]mathlingua.File{{Path: "a.math", Content: text}})
+//	for _, diag := range ws.Check() {
+//		fmt.Println(diag)
+//	}
+package mathlingua
+
+// Version is the version of this API, which follows semantic versioning.
+const Version = "1.0.0"
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mathlingua
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const groupInput = `[\group]
+Describes: G
+Documented:
+. called: "group"
+. written: "\mathcal{G}"
+------------------------------------------
+Id: "1"
+`
+
+const theoremInput = `Theorem:
+given: G
+then: 'G is \group'
+------------------------------------------
+Id: "2"
+`
+
+func TestParseDocument(t *testing.T) {
+	doc, diagnostics := ParseDocument(File{Path: "a.math", Content: groupInput})
+	assert.Empty(t, diagnostics)
+	assert.Equal(t, "a.math", doc.Path)
+	assert.Equal(t, 1, len(doc.Nodes))
+
+	group := doc.Nodes[0]
+	assert.Equal(t, GroupNode, group.Kind)
+	assert.Equal(t, Position{Offset: 9, Row: 1, Column: 0}, group.Position)
+	assert.Equal(t, `\group`, group.Id)
+	assert.Equal(t, "Describes", group.Children[0].Name)
+	assert.Equal(t, Position{Offset: 9, Row: 1, Column: 0}, group.Children[0].Position)
+	assert.Equal(t, ArgumentTextNode, group.Children[0].Children[0].Kind)
+	assert.Equal(t, "G", group.Children[0].Children[0].Text)
+}
+
+func TestCheck(t *testing.T) {
+	workspace := NewWorkspace([]File{
+		{Path: "a.math", Content: groupInput},
+		{Path: "b.math", Content: "Theorem:\nthen: 'x is \\unknown'\n-----\nId: \"3\"\n"},
+	})
+	diagnostics := workspace.Check()
+	assert.Equal(t, 2, len(diagnostics))
+	assert.Equal(t, "unrecognized-signature", diagnostics[0].Code)
+	assert.Equal(t, Error, diagnostics[0].Severity)
+	assert.Equal(t, "b.math", diagnostics[0].Path)
+	assert.Equal(t, 1, diagnostics[0].Position.Row)
+
+	// checking again does not report the problems twice
+	assert.Equal(t, diagnostics, workspace.Check())
+}
+
+func TestEntries(t *testing.T) {
+	workspace, err := LoadFS(fstest.MapFS{
+		"content/group.math":   {Data: []byte(groupInput)},
+		"content/theorem.math": {Data: []byte(theoremInput)},
+		"content/notes.txt":    {Data: []byte("not mathlingua")},
+		".hidden/other.math":   {Data: []byte(groupInput)},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(workspace.Files()))
+	assert.Empty(t, workspace.Check())
+
+	entries := workspace.Entries()
+	assert.Equal(t, 2, len(entries))
+	assert.Equal(t, "Theorem", entries[1].Kind)
+
+	entry, ok := workspace.EntryBySignature(`\:group`)
+	assert.True(t, ok)
+	assert.Equal(t, Entry{
+		Id:        "1",
+		Kind:      "Describes",
+		Signature: `\:group`,
+		Path:      "content/group.math",
+		Position:  Position{Offset: 9, Row: 1, Column: 0},
+		Called:    []string{"group"},
+		Written:   []string{`\mathcal{G}`},
+	}, entry)
+
+	entry, ok = workspace.EntryById("2")
+	assert.True(t, ok)
+	assert.Equal(t, "content/theorem.math", entry.Path)
+
+	_, ok = workspace.EntryById("unknown")
+	assert.False(t, ok)
+}
+
+func TestRenderWritten(t *testing.T) {
+	workspace := NewWorkspace([]File{{Path: "a.math", Content: groupInput}})
+
+	written, err := workspace.RenderWritten(`\group`)
+	assert.Nil(t, err)
+	assert.Equal(t, `\mathcal{G}`, written)
+
+	_, err = workspace.RenderWritten(`x is (`)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mathlingua
+
+import (
+	"mathlingua/internal/ast"
+	"mathlingua/internal/backend"
+	"mathlingua/internal/frontend"
+	"mathlingua/internal/frontend/structural/phase4"
+	"sort"
+)
+
+// ParseDocument parses the structure of the given document and returns the problems found
+// while parsing it.
+func ParseDocument(file File) (Document, []Diagnostic) {
+	root, diagnostics := ParseRoot([]File{file})
+	return root[0], diagnostics
+}
+
+// ParseRoot parses the structure of the given documents, where operators declared in any
+// document apply to all of them, and returns the documents in the order given.
+func ParseRoot(files []File) ([]Document, []Diagnostic) {
+	texts := make(map[ast.Path]string)
+	for _, file := range files {
+		texts[ast.ToPath(file.Path)] = file.Content
+	}
+	tracker := frontend.NewDiagnosticTracker()
+	phase4Root, _, _ := backend.ParseRoot(texts, tracker, nil)
+
+	documents := make([]Document, 0, len(files))
+	for _, file := range files {
+		positions := newPositionIndex(file.Content)
+		doc := Document{
+			Path:  file.Path,
+			Nodes: make([]Node, 0),
+		}
+		for _, node := range phase4Root.Documents[ast.ToPath(file.Path)].Nodes {
+			if n, ok := toNode(node, positions); ok {
+				doc.Nodes = append(doc.Nodes, n)
+			}
+		}
+		documents = append(documents, doc)
+	}
+	return documents, toDiagnostics(tracker.Diagnostics(), files)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func toNode(node phase4.Node, positions positionIndex) (Node, bool) {
+	switch n := node.(type) {
+	case *phase4.Group:
+		result := Node{
+			Kind:     GroupNode,
+			Position: positions.at(n.MetaData.Start.Offset),
+			Children: make([]Node, 0, len(n.Sections)),
+		}
+		if n.Id != nil {
+			result.Id = *n.Id
+		}
+		for i := range n.Sections {
+			if section, ok := toNode(&n.Sections[i], positions); ok {
+				result.Children = append(result.Children, section)
+			}
+		}
+		if len(result.Children) > 0 {
+			result.Position = result.Children[0].Position
+		}
+		return result, true
+	case *phase4.Section:
+		result := Node{
+			Kind:     SectionNode,
+			Name:     n.Name,
+			Position: positions.at(n.MetaData.Start.Offset),
+			Children: make([]Node, 0, len(n.Args)),
+		}
+		for _, arg := range n.Args {
+			if child, ok := toNode(arg.Arg, positions); ok {
+				result.Children = append(result.Children, child)
+			}
+		}
+		return result, true
+	case *phase4.FormulationArgumentData:
+		return toTextNode(FormulationNode, n.Text, n.MetaData, positions), true
+	case *phase4.TextArgumentData:
+		return toTextNode(TextNode, n.Text, n.MetaData, positions), true
+	case *phase4.ArgumentTextArgumentData:
+		return toTextNode(ArgumentTextNode, n.Text, n.MetaData, positions), true
+	case *phase4.TextBlock:
+		return toTextNode(TextBlockNode, n.Text, n.MetaData, positions), true
+	default:
+		return Node{}, false
+	}
+}
+
+func toTextNode(
+	kind NodeKind,
+	text string,
+	metaData phase4.MetaData,
+	positions positionIndex,
+) Node {
+	return Node{
+		Kind:     kind,
+		Text:     text,
+		Position: positions.at(metaData.Start.Offset),
+		Children: make([]Node, 0),
+	}
+}
+
+func toDiagnostics(diagnostics []frontend.Diagnostic, files []File) []Diagnostic {
+	positions := make(map[string]positionIndex)
+	for _, file := range files {
+		positions[string(ast.ToPath(file.Path))] = newPositionIndex(file.Content)
+	}
+	result := make([]Diagnostic, 0, len(diagnostics))
+	for _, diag := range diagnostics {
+		severity := Error
+		if diag.Type == frontend.Warning {
+			severity = Warning
+		}
+		suggestions := make([]string, 0, len(diag.Suggestions))
+		suggestions = append(suggestions, diag.Suggestions...)
+		result = append(result, Diagnostic{
+			Severity:    severity,
+			Code:        string(diag.Code),
+			Message:     diag.Message,
+			Path:        string(diag.Path),
+			Position:    positions[string(diag.Path)].at(diag.Position.Offset),
+			Suggestions: suggestions,
+		})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Path != result[j].Path {
+			return result[i].Path < result[j].Path
+		}
+		return result[i].Position.Offset < result[j].Position.Offset
+	})
+	return result
+}
+
+// positionIndex maps the byte offsets in a text to positions
+type positionIndex backend.PositionIndex
+
+func newPositionIndex(text string) positionIndex {
+	return positionIndex(backend.NewPositionIndex(text))
+}
+
+func (p positionIndex) at(offset int) Position {
+	position := backend.PositionIndex(p).At(offset)
+	return Position{
+		Offset: position.Offset,
+		Row:    position.Row,
+		Column: position.Column,
+	}
+}
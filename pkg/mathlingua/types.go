@@ -0,0 +1,112 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mathlingua
+
+import "fmt"
+
+// Position identifies a location in a document where Offset is a byte offset and Row and
+// Column are zero based with Column counting characters.
+type Position struct {
+	Offset int
+	Row    int
+	Column int
+}
+
+type Severity string
+
+const (
+	Error   Severity = "error"
+	Warning Severity = "warning"
+)
+
+// Diagnostic describes a problem found in a document.
+type Diagnostic struct {
+	Severity Severity
+	// identifies the kind of problem, for example "unrecognized-signature"
+	Code     string
+	Message  string
+	Path     string
+	Position Position
+	// the known signatures similar to an unrecognized one, if any
+	Suggestions []string
+}
+
+// String returns the diagnostic as it is reported by `mlg check` with one based rows and
+// columns.
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s: %s (%d, %d) [%s]\n%s", d.Severity, d.Path, d.Position.Row+1,
+		d.Position.Column+1, d.Code, d.Message)
+}
+
+// File is the path and content of a Mathlingua (.math) document.
+type File struct {
+	Path    string
+	Content string
+}
+
+type NodeKind string
+
+const (
+	// a group of sections such as a Theorem: entry or a nested exists: group
+	GroupNode NodeKind = "group"
+	// a section of a group where Name is the name of the section, for example given
+	SectionNode NodeKind = "section"
+	// a formulation such as 'x + y' where Text is the text between the quotes
+	FormulationNode NodeKind = "formulation"
+	// text such as "a group" where Text is the text between the quotes
+	TextNode NodeKind = "text"
+	// an argument such as x in given: x
+	ArgumentTextNode NodeKind = "argumentText"
+	// a top-level text block such as ::some text:: where Text is the text between the ::
+	TextBlockNode NodeKind = "textBlock"
+)
+
+// Node is a node of the structure of a document.  A group's children are its sections and
+// a section's children are its arguments.  The position of a group is the position of its
+// first section.
+type Node struct {
+	Kind NodeKind
+	// the name of a section
+	Name string
+	// the text of a formulation, text, argument, or text block
+	Text string
+	// the text between the [ and ] before a group, if any
+	Id       string
+	Position Position
+	Children []Node
+}
+
+// Document is the parsed structure of a Mathlingua document.
+type Document struct {
+	Path  string
+	Nodes []Node
+}
+
+// Entry summarizes a top-level entry, such as a Theorem: or Describes:, with an Id: section.
+type Entry struct {
+	Id string
+	// the name of the first section of the entry, for example Theorem
+	Kind string
+	// the signature of the entry, for example \:set, or "" if it does not have one
+	Signature string
+	Path      string
+	// the position of the first section of the entry
+	Position Position
+	// the raw Documented:called: and Documented:written: forms of the entry
+	Called  []string
+	Written []string
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright 2024 Dominic Kramer
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mathlingua
+
+import (
+	"io/fs"
+	"mathlingua/internal/ast"
+	"mathlingua/internal/backend"
+	"mathlingua/internal/config"
+	"mathlingua/internal/frontend"
+	"path"
+)
+
+// Workspace is a collection of documents that are checked together, where the entries in
+// any document can be referenced by the others.
+type Workspace struct {
+	workspace *backend.Workspace
+	files     []File
+	positions map[ast.Path]positionIndex
+	// the problems found while loading the documents
+	loadDiagnostics []frontend.Diagnostic
+	diagnostics     []Diagnostic
+	checked         bool
+}
+
+// NewWorkspace creates a workspace from the given in-memory documents.
+func NewWorkspace(files []File) *Workspace {
+	contents := make([]backend.PathLabelContent, 0, len(files))
+	for _, file := range files {
+		content := file.Content
+		contents = append(contents, backend.PathLabelContent{
+			Path:    ast.ToPath(file.Path),
+			Label:   path.Base(string(ast.ToPath(file.Path))),
+			Content: &content,
+		})
+	}
+	return newWorkspace(
+		backend.NewWorkspace(contents, frontend.NewDiagnosticTracker(), nil), nil)
+}
+
+// LoadFS creates a workspace from the Mathlingua (.math) files in the given file system,
+// such as an os.DirFS, an embed.FS, or a zip.Reader, in the order given by any toc.conf
+// files.  Hidden files and directories, such as .git, are skipped.  Problems with the
+// files, such as an invalid toc.conf, are reported by Check.  Unlike `mlg check`, the files
+// are not modified, so entries without an Id: section are not given one.  The Unicode
+// normalizations in an mlg.conf file at the root of the file system are used when parsing.
+func LoadFS(fsys fs.FS) (*Workspace, error) {
+	if _, err := fs.Stat(fsys, "."); err != nil {
+		return nil, err
+	}
+	tracker := frontend.NewDiagnosticTracker()
+	conf := config.LoadMlgConfigFS(fsys, tracker)
+	workspace, diagnostics := backend.NewWorkspaceFromFS(fsys, nil, tracker,
+		backend.GetUnicodeTable(*conf))
+	return newWorkspace(workspace, diagnostics), nil
+}
+
+func newWorkspace(
+	workspace *backend.Workspace,
+	loadDiagnostics []frontend.Diagnostic,
+) *Workspace {
+	files := make([]File, 0)
+	positions := make(map[ast.Path]positionIndex)
+	for _, pair := range workspace.Paths() {
+		if content, ok := workspace.GetContent(pair.Path); ok {
+			files = append(files, File{
+				Path:    string(pair.Path),
+				Content: content,
+			})
+			positions[pair.Path] = newPositionIndex(content)
+		}
+	}
+	return &Workspace{
+		workspace:       workspace,
+		files:           files,
+		positions:       positions,
+		loadDiagnostics: loadDiagnostics,
+	}
+}
+
+// Files returns the documents in the workspace.
+func (w *Workspace) Files() []File {
+	result := make([]File, len(w.files))
+	copy(result, w.files)
+	return result
+}
+
+// Check returns the problems found in the documents of the workspace.
+func (w *Workspace) Check() []Diagnostic {
+	if !w.checked {
+		checked := w.workspace.Check().Diagnostics
+		diagnostics := make([]frontend.Diagnostic, 0, len(w.loadDiagnostics)+len(checked))
+		diagnostics = append(diagnostics, w.loadDiagnostics...)
+		diagnostics = append(diagnostics, checked...)
+		w.diagnostics = toDiagnostics(diagnostics, w.files)
+		w.checked = true
+	}
+	result := make([]Diagnostic, len(w.diagnostics))
+	copy(result, w.diagnostics)
+	return result
+}
+
+// Entries returns the entries with an Id: section sorted by path and then position.
+func (w *Workspace) Entries() []Entry {
+	result := make([]Entry, 0)
+	for _, info := range w.workspace.GetEntryInfos() {
+		result = append(result, w.toEntry(info))
+	}
+	return result
+}
+
+// EntryById returns the entry with the given Id: section.
+func (w *Workspace) EntryById(id string) (Entry, bool) {
+	info, ok := w.workspace.GetEntryInfoById(id)
+	if !ok {
+		return Entry{}, false
+	}
+	return w.toEntry(info), true
+}
+
+// EntryBySignature returns the entry that defines the given signature, for example \:set.
+func (w *Workspace) EntryBySignature(signature string) (Entry, bool) {
+	info, ok := w.workspace.GetEntryInfoBySignature(signature)
+	if !ok {
+		return Entry{}, false
+	}
+	return w.toEntry(info), true
+}
+
+// RenderWritten returns the LaTeX for the given formulation, such as x \in \set, using the
+// written: forms of the entries in the workspace.
+func (w *Workspace) RenderWritten(formulation string) (string, error) {
+	return w.workspace.RenderFormulation(formulation)
+}
+
+func (w *Workspace) toEntry(info backend.EntryInfo) Entry {
+	return Entry{
+		Id:        info.Id,
+		Kind:      info.Kind,
+		Signature: info.Signature,
+		Path:      string(info.Path),
+		Position:  w.positions[info.Path].at(info.Position.Offset),
+		Called:    info.Called,
+		Written:   info.Written,
+	}
+}
@@ -17,10 +17,20 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/fs"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 )
@@ -53,15 +63,19 @@ func getUnionFiles() ([]string, error) {
 	return result, nil
 }
 
-func processUnionFile(unionFilePath string) error {
+// processUnionFile writes the Go file for the given .unions file and returns the unions
+// declared in it and the lines before its package clause, such as its license.
+func processUnionFile(unionFilePath string) ([]union, string, error) {
 	bytes, err := os.ReadFile(unionFilePath)
 	if err != nil {
-		return err
+		return nil, "", err
 	}
 	content := string(bytes)
 	lines := strings.Split(content, "\n")
 
 	out := ""
+	header := ""
+	unions := make([]union, 0)
 
 	row := 0
 	for row < len(lines) {
@@ -72,22 +86,22 @@ func processUnionFile(unionFilePath string) error {
 			parts := strings.Fields(ln)
 
 			if parts[0] != "union" {
-				return fmt.Errorf("%s (%d): Expected the first token to be 'union'",
+				return nil, "", fmt.Errorf("%s (%d): Expected the first token to be 'union'",
 					unionFilePath, row+1)
 			}
 
 			if parts[len(parts)-1] != "{" {
-				return fmt.Errorf("%s (%d): Expected the line to end with }",
+				return nil, "", fmt.Errorf("%s (%d): Expected the line to end with }",
 					unionFilePath, row+1)
 			}
 
 			if len(parts) != 3 && len(parts) != 5 {
-				return fmt.Errorf("%s (%d): Expected 'union X {' or 'union X extends {'",
+				return nil, "", fmt.Errorf("%s (%d): Expected 'union X {' or 'union X extends {'",
 					unionFilePath, row+1)
 			}
 
 			if len(parts) == 5 && parts[2] != "extends" {
-				return fmt.Errorf("%s (%d): Expected the third token to be 'extends'",
+				return nil, "", fmt.Errorf("%s (%d): Expected the third token to be 'extends'",
 					unionFilePath, row+1)
 			}
 
@@ -102,7 +116,7 @@ func processUnionFile(unionFilePath string) error {
 			functions := make([]string, 0)
 
 			for row < len(lines) {
-				elementLine := strings.Trim(lines[row], " ")
+				elementLine := strings.TrimSpace(lines[row])
 				row += 1
 				if len(elementLine) == 0 {
 					continue
@@ -132,7 +146,15 @@ func processUnionFile(unionFilePath string) error {
 			}
 
 			out += "\n"
+
+			unions = append(unions, union{
+				name:     unionName,
+				elements: elements,
+			})
 		} else {
+			if strings.HasPrefix(ln, "package") && header == "" {
+				header = out
+			}
 			out += ln
 			out += "\n"
 		}
@@ -140,11 +162,65 @@ func processUnionFile(unionFilePath string) error {
 
 	outputFilepath := strings.Replace(unionFilePath, UNIONS_SUFFIX, ".go", 1)
 	err = os.WriteFile(outputFilepath, []byte(out), 0644)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return unions, header, nil
+}
+
+// processPackage writes the JSON encoding and decoding of the unions of the package in the
+// given directory, the JSON schema of the package, and, if the package declares the
+// NODE_UNION, the functions that walk, transform, and clone its nodes.
+func processPackage(loader *packageLoader, dir string, unions []union, header string) error {
+	pkg, err := loader.loadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, u := range unions {
+		// types can also be added to a union by declaring its method outside of the
+		// .unions file
+		listed := make(map[string]bool)
+		for _, element := range u.elements {
+			listed[getBaseName(element)] = true
+		}
+		markers := pkg.markers[u.name]
+		sort.Strings(markers)
+		for _, name := range markers {
+			if !listed[name] {
+				listed[name] = true
+				u.elements = append(u.elements, name)
+			}
+		}
+		pkg.unions[u.name] = u
+	}
+
+	source, err := generateJson(pkg, header)
 	if err != nil {
 		return err
 	}
+	if err := os.WriteFile(filepath.Join(dir, JSON_FILENAME), source, 0644); err != nil {
+		return err
+	}
+
+	if _, ok := pkg.unions[NODE_UNION]; ok {
+		source, err := generateWalk(pkg, header)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, WALK_FILENAME), source, 0644); err != nil {
+			return err
+		}
+	}
 
-	return exec.Command("go", "fmt", "./...").Run()
+	schema, err := generateSchema(loader, pkg)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(SCHEMA_DIR, 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(SCHEMA_DIR, pkg.name+".schema.json"), schema, 0644)
 }
 
 func main() {
@@ -154,13 +230,1912 @@ func main() {
 		return
 	}
 
+	cwd, err := os.Getwd()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	var lock sync.Mutex
+	unionsByDir := make(map[string][]union)
+	// the header of the first .unions file in each directory
+	headersByDir := make(map[string]string)
+	headerFiles := make(map[string]string)
+
 	var group sync.WaitGroup
 	for _, filename := range unionFiles {
 		group.Add(1)
 		go func(name string) {
 			defer group.Done()
-			processUnionFile(name)
+			unions, header, err := processUnionFile(name)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+			dir, err := filepath.Rel(cwd, filepath.Dir(name))
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			unionsByDir[dir] = append(unionsByDir[dir], unions...)
+			if first, ok := headerFiles[dir]; !ok || name < first {
+				headerFiles[dir] = name
+				headersByDir[dir] = header
+			}
 		}(filename)
 	}
 	group.Wait()
+
+	// the packages are processed after all of the .unions files are written since the
+	// generated Go files are parsed when processing a package
+	loader, err := newPackageLoader()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, dir := range sortedKeys(unionsByDir) {
+		unions := unionsByDir[dir]
+		sort.Slice(unions, func(i, j int) bool {
+			return unions[i].name < unions[j].name
+		})
+		if err := processPackage(loader, dir, unions, headersByDir[dir]); err != nil {
+			fmt.Println(err)
+		}
+	}
+
+	if err := exec.Command("go", "fmt", "./...").Run(); err != nil {
+		fmt.Println(err)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// union is a union declared in a .unions file where elements are the types in the union as
+// written, for example Formulation[T].
+type union struct {
+	name     string
+	elements []string
+}
+
+// goPackage describes the type declarations in a Go package.
+type goPackage struct {
+	name string
+	dir  string
+	// the unions declared in the .unions files of the package
+	unions map[string]union
+	// maps the name of each type declared in the package to its declaration
+	types map[string]*ast.TypeSpec
+	// maps the name an imported package is referenced by to its import path
+	imports map[string]string
+	// maps the name of each method without parameters or results, such as the methods
+	// that mark the types in a union, to the types with the method
+	markers map[string][]string
+}
+
+// packageLoader loads and caches the Go packages in the module rooted at the working
+// directory.
+type packageLoader struct {
+	modulePath string
+	packages   map[string]*goPackage
+}
+
+func newPackageLoader() (*packageLoader, error) {
+	bytes, err := os.ReadFile("go.mod")
+	if err != nil {
+		return nil, err
+	}
+	modulePath := ""
+	for _, line := range strings.Split(string(bytes), "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 && fields[0] == "module" {
+			modulePath = fields[1]
+		}
+	}
+	if modulePath == "" {
+		return nil, fmt.Errorf("go.mod does not declare a module path")
+	}
+	return &packageLoader{
+		modulePath: modulePath,
+		packages:   make(map[string]*goPackage),
+	}, nil
+}
+
+func (l *packageLoader) loadDir(dir string) (*goPackage, error) {
+	if pkg, ok := l.packages[dir]; ok {
+		return pkg, nil
+	}
+
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseDir(fset, dir, func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != JSON_FILENAME &&
+			info.Name() != WALK_FILENAME
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed) != 1 {
+		return nil, fmt.Errorf("%s: Expected exactly one package", dir)
+	}
+
+	pkg := &goPackage{
+		dir:     dir,
+		unions:  make(map[string]union),
+		types:   make(map[string]*ast.TypeSpec),
+		imports: make(map[string]string),
+		markers: make(map[string][]string),
+	}
+	for name, astPkg := range parsed {
+		pkg.name = name
+		for _, file := range astPkg.Files {
+			for _, spec := range file.Imports {
+				importPath, _ := strconv.Unquote(spec.Path.Value)
+				importName := path.Base(importPath)
+				if spec.Name != nil {
+					importName = spec.Name.Name
+				}
+				pkg.imports[importName] = importPath
+			}
+			for _, decl := range file.Decls {
+				if receiver, method, ok := getMarkerMethod(decl); ok {
+					pkg.markers[method] = append(pkg.markers[method], receiver)
+				}
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok || genDecl.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range genDecl.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					pkg.types[typeSpec.Name.Name] = typeSpec
+				}
+			}
+		}
+	}
+	l.packages[dir] = pkg
+	return pkg, nil
+}
+
+// getMarkerMethod returns the receiver type and name of the given declaration if it is a
+// method without parameters or results.
+func getMarkerMethod(decl ast.Decl) (string, string, bool) {
+	funcDecl, ok := decl.(*ast.FuncDecl)
+	if !ok || funcDecl.Recv == nil || len(funcDecl.Recv.List) != 1 ||
+		len(funcDecl.Type.Params.List) != 0 || funcDecl.Type.Results != nil {
+		return "", "", false
+	}
+	receiver := funcDecl.Recv.List[0].Type
+	if star, ok := receiver.(*ast.StarExpr); ok {
+		receiver = star.X
+	}
+	return getBaseName(exprToString(receiver)), funcDecl.Name.Name, true
+}
+
+func (l *packageLoader) loadImport(pkg *goPackage, name string) (*goPackage, error) {
+	importPath, ok := pkg.imports[name]
+	if !ok || !strings.HasPrefix(importPath, l.modulePath+"/") {
+		return nil, fmt.Errorf("%s: Cannot load the package %s", pkg.dir, name)
+	}
+	return l.loadDir(filepath.FromSlash(strings.TrimPrefix(importPath, l.modulePath+"/")))
+}
+
+func (p *goPackage) getStruct(name string) (*ast.StructType, bool) {
+	spec, ok := p.types[name]
+	if !ok {
+		return nil, false
+	}
+	structType, ok := spec.Type.(*ast.StructType)
+	return structType, ok
+}
+
+// getTypeParams returns the names of the type parameters of the given type mapped to their
+// constraints.
+func (p *goPackage) getTypeParams(name string) ([]string, map[string]ast.Expr) {
+	names := make([]string, 0)
+	constraints := make(map[string]ast.Expr)
+	if spec, ok := p.types[name]; ok && spec.TypeParams != nil {
+		for _, field := range spec.TypeParams.List {
+			for _, paramName := range field.Names {
+				names = append(names, paramName.Name)
+				constraints[paramName.Name] = field.Type
+			}
+		}
+	}
+	return names, constraints
+}
+
+// instantiate returns the given type with its type parameters set to their constraints.
+func (p *goPackage) instantiate(name string) string {
+	params, constraints := p.getTypeParams(name)
+	if len(params) == 0 {
+		return name
+	}
+	args := make([]string, 0, len(params))
+	for _, param := range params {
+		args = append(args, exprToString(constraints[param]))
+	}
+	return fmt.Sprintf("%s[%s]", name, strings.Join(args, ", "))
+}
+
+// getReceiverType returns the given type with its type parameters as its type arguments,
+// for example Formulation[T].
+func (p *goPackage) getReceiverType(name string) string {
+	params, _ := p.getTypeParams(name)
+	if len(params) == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s[%s]", name, strings.Join(params, ", "))
+}
+
+func (p *goPackage) getUnionName(expr ast.Expr) (string, bool) {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	_, ok = p.unions[ident.Name]
+	return ident.Name, ok
+}
+
+// getElementNames returns the names of the types in any union of the package, without type
+// arguments, sorted by name.
+func (p *goPackage) getElementNames() []string {
+	names := make(map[string]bool)
+	for _, u := range p.unions {
+		for _, element := range u.elements {
+			names[getBaseName(element)] = true
+		}
+	}
+	return sortedKeys(names)
+}
+
+func getBaseName(element string) string {
+	if index := strings.Index(element, "["); index >= 0 {
+		return element[:index]
+	}
+	return element
+}
+
+func sortedKeys[T any](items map[string]T) []string {
+	result := make([]string, 0, len(items))
+	for key := range items {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func exprToString(expr ast.Expr) string {
+	var builder strings.Builder
+	printer.Fprint(&builder, token.NewFileSet(), expr)
+	return builder.String()
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type fieldShape int
+
+const (
+	plainShape fieldShape = iota
+	// U
+	unionShape
+	// *U
+	pointerShape
+	// []U
+	sliceShape
+	// *[]U
+	slicePointerShape
+	// map[K]U
+	mapShape
+	// mlglib.Optional[U]
+	optionalShape
+	// T where the constraint of the type parameter T is U
+	typeParamShape
+)
+
+// unionField is a field of a struct whose type refers to a union and so needs to be
+// decoded with the Unmarshal function of the union.
+type unionField struct {
+	name  string
+	shape fieldShape
+	union string
+	// the type arguments, such as [string] for map[string]U, that cannot be inferred in
+	// the call to the helper that decodes the field
+	typeArgs string
+}
+
+func (p *goPackage) getUnionFields(name string) ([]unionField, error) {
+	structType, _ := p.getStruct(name)
+	_, constraints := p.getTypeParams(name)
+	result := make([]unionField, 0)
+	for _, field := range structType.Fields.List {
+		if field.Tag != nil {
+			return nil, fmt.Errorf("%s: The struct tags of %s are not supported", p.dir, name)
+		}
+		if len(field.Names) == 0 {
+			return nil, fmt.Errorf("%s: The embedded fields of %s are not supported", p.dir, name)
+		}
+		shape, unionName, typeArgs := p.getFieldShape(field.Type, constraints)
+		for _, fieldName := range field.Names {
+			if fieldName.Name == KIND_FIELD {
+				return nil, fmt.Errorf("%s: The %s field of %s conflicts with the JSON %s",
+					p.dir, KIND_FIELD, name, KIND_FIELD)
+			}
+			if shape != plainShape && fieldName.IsExported() {
+				result = append(result, unionField{
+					name:     fieldName.Name,
+					shape:    shape,
+					union:    unionName,
+					typeArgs: typeArgs,
+				})
+			}
+		}
+	}
+	return result, nil
+}
+
+func (p *goPackage) getFieldShape(
+	expr ast.Expr,
+	constraints map[string]ast.Expr,
+) (fieldShape, string, string) {
+	if name, ok := p.getUnionName(expr); ok {
+		return unionShape, name, ""
+	}
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if constraint, ok := constraints[e.Name]; ok {
+			if name, ok := p.getUnionName(constraint); ok {
+				return typeParamShape, name, fmt.Sprintf("[%s]", e.Name)
+			}
+		}
+	case *ast.StarExpr:
+		if name, ok := p.getUnionName(e.X); ok {
+			return pointerShape, name, ""
+		}
+		if array, ok := e.X.(*ast.ArrayType); ok && array.Len == nil {
+			if name, ok := p.getUnionName(array.Elt); ok {
+				return slicePointerShape, name, ""
+			}
+		}
+	case *ast.ArrayType:
+		if name, ok := p.getUnionName(e.Elt); ok && e.Len == nil {
+			return sliceShape, name, ""
+		}
+	case *ast.MapType:
+		if name, ok := p.getUnionName(e.Value); ok {
+			return mapShape, name, fmt.Sprintf("[%s]", exprToString(e.Key))
+		}
+	case *ast.IndexExpr:
+		if exprToString(e.X) == "mlglib.Optional" {
+			if name, ok := p.getUnionName(e.Index); ok {
+				return optionalShape, name, ""
+			}
+		}
+	}
+	return plainShape, "", ""
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// jsonGenerator generates the JSON encoding and decoding of the unions of a package.
+type jsonGenerator struct {
+	pkg     *goPackage
+	out     strings.Builder
+	helpers map[string]bool
+	// the declarations of the aliases of generic types
+	aliases map[string]string
+}
+
+func generateJson(pkg *goPackage, header string) ([]byte, error) {
+	g := jsonGenerator{
+		pkg:     pkg,
+		helpers: make(map[string]bool),
+		aliases: make(map[string]string),
+	}
+
+	elements := make(map[string]bool)
+	for _, name := range pkg.getElementNames() {
+		if _, ok := pkg.getStruct(name); !ok {
+			return nil, fmt.Errorf("%s: The union element %s is not a struct", pkg.dir, name)
+		}
+		elements[name] = true
+	}
+
+	for _, name := range sortedKeys(pkg.unions) {
+		g.writeUnmarshalUnion(pkg.unions[name])
+	}
+
+	for _, name := range sortedKeys(pkg.types) {
+		if _, ok := pkg.getStruct(name); !ok {
+			continue
+		}
+		fields, err := pkg.getUnionFields(name)
+		if err != nil {
+			return nil, err
+		}
+		hasOptional := false
+		for _, field := range fields {
+			hasOptional = hasOptional || field.shape == optionalShape
+		}
+		if elements[name] || hasOptional {
+			g.writeMarshal(name, elements[name], fields)
+		}
+		if len(fields) > 0 {
+			g.writeUnmarshal(name, fields)
+		}
+	}
+
+	var result strings.Builder
+	result.WriteString(header)
+	result.WriteString("// Code generated by scripts/unions.go from the .unions files. " +
+		"DO NOT EDIT.\n\n")
+	fmt.Fprintf(&result, "package %s\n\n", pkg.name)
+	result.WriteString("import (\n\t\"bytes\"\n\t\"encoding/json\"\n\t\"fmt\"\n")
+	if g.helpers["unmarshalOptional"] {
+		fmt.Fprintf(&result, "\t%q\n", pkg.imports["mlglib"])
+	}
+	result.WriteString(")\n\n")
+	result.WriteString(g.out.String())
+	result.WriteString(SECTION_SEPARATOR)
+	g.helpers["isNullJson"] = true
+	g.helpers["unmarshalKind"] = true
+	for _, name := range sortedKeys(JSON_HELPERS) {
+		if g.helpers[name] {
+			result.WriteString(JSON_HELPERS[name])
+			result.WriteString("\n")
+		}
+	}
+	for _, name := range sortedKeys(g.aliases) {
+		result.WriteString(g.aliases[name])
+	}
+	return format.Source([]byte(result.String()))
+}
+
+func (g *jsonGenerator) writeUnmarshalUnion(u union) {
+	out := &g.out
+	fmt.Fprintf(out, "// Unmarshal%s decodes the JSON encoding of the union, where the\n",
+		u.name)
+	fmt.Fprintf(out, "// %q of the JSON object determines its type and null is decoded as nil.\n",
+		KIND_FIELD)
+	signature := fmt.Sprintf("func Unmarshal%s(data []byte) (%s, error) {\n", u.name, u.name)
+	if len(signature) > MAX_LINE_LENGTH {
+		signature = fmt.Sprintf("func Unmarshal%s(\n\tdata []byte,\n) (%s, error) {\n",
+			u.name, u.name)
+	}
+	out.WriteString(signature)
+	out.WriteString("\tif isNullJson(data) {\n\t\treturn nil, nil\n\t}\n")
+	out.WriteString("\tkind, err := unmarshalKind(data)\n")
+	out.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+	unknown := fmt.Sprintf("\treturn nil, fmt.Errorf(\"%%q is not a kind of %s\", kind)\n", u.name)
+	if len(u.elements) == 0 {
+		out.WriteString(unknown)
+		out.WriteString("}\n\n")
+		return
+	}
+	fmt.Fprintf(out, "\tvar node %s\n", u.name)
+	out.WriteString("\tswitch kind {\n")
+	for _, element := range u.elements {
+		name := getBaseName(element)
+		fmt.Fprintf(out, "\tcase %q:\n", name)
+		fmt.Fprintf(out, "\t\tnode = &%s{}\n", g.pkg.instantiate(name))
+	}
+	out.WriteString("\tdefault:\n\t")
+	out.WriteString(unknown)
+	out.WriteString("\t}\n")
+	out.WriteString("\tif err := json.Unmarshal(data, node); err != nil {\n")
+	out.WriteString("\t\treturn nil, err\n\t}\n")
+	out.WriteString("\treturn node, nil\n}\n\n")
+}
+
+// getAlias returns the declaration, if any, and the name of a type with the same fields as
+// the given type but without its methods.  Generic types need an alias declared outside of
+// the method since type declarations are not supported in generic functions.
+func (g *jsonGenerator) getAlias(name string) (string, string, string) {
+	params, constraints := g.pkg.getTypeParams(name)
+	if len(params) == 0 {
+		return fmt.Sprintf("\ttype alias %s\n", name), "alias", "alias"
+	}
+	decls := make([]string, 0, len(params))
+	for _, param := range params {
+		decls = append(decls, fmt.Sprintf("%s %s", param, exprToString(constraints[param])))
+	}
+	aliasName := "json" + name
+	args := strings.Join(params, ", ")
+	g.aliases[aliasName] = fmt.Sprintf("type %s[%s] %s[%s]\n", aliasName,
+		strings.Join(decls, ", "), name, args)
+	return "", aliasName, fmt.Sprintf("%s[%s]", aliasName, args)
+}
+
+func (g *jsonGenerator) writeMarshal(name string, isElement bool, fields []unionField) {
+	out := &g.out
+	aliasDecl, aliasField, aliasType := g.getAlias(name)
+	fmt.Fprintf(out, "func (n %s) MarshalJSON() ([]byte, error) {\n", g.pkg.getReceiverType(name))
+	out.WriteString(aliasDecl)
+	out.WriteString("\tfields := struct {\n")
+	if isElement {
+		fmt.Fprintf(out, "\t\t%s string\n", KIND_FIELD)
+	}
+	fmt.Fprintf(out, "\t\t%s\n", aliasType)
+	for _, field := range fields {
+		if field.shape == optionalShape {
+			fmt.Fprintf(out, "\t\t%s %s\n", field.name, field.union)
+		}
+	}
+	out.WriteString("\t}{\n")
+	if isElement {
+		fmt.Fprintf(out, "\t\t%s: %q,\n", KIND_FIELD, name)
+	}
+	fmt.Fprintf(out, "\t\t%s: %s(n),\n", aliasField, aliasType)
+	out.WriteString("\t}\n")
+	for _, field := range fields {
+		if field.shape == optionalShape {
+			fmt.Fprintf(out, "\tfields.%s, _ = n.%s.Get()\n", field.name, field.name)
+		}
+	}
+	out.WriteString("\treturn json.Marshal(fields)\n}\n\n")
+}
+
+func (g *jsonGenerator) writeUnmarshal(name string, fields []unionField) {
+	out := &g.out
+	aliasDecl, aliasField, aliasType := g.getAlias(name)
+	fmt.Fprintf(out, "func (n *%s) UnmarshalJSON(data []byte) error {\n",
+		g.pkg.getReceiverType(name))
+	out.WriteString(aliasDecl)
+	out.WriteString("\tfields := struct {\n")
+	fmt.Fprintf(out, "\t\t*%s\n", aliasType)
+	for _, field := range fields {
+		fmt.Fprintf(out, "\t\t%s json.RawMessage\n", field.name)
+	}
+	out.WriteString("\t}{\n")
+	fmt.Fprintf(out, "\t\t%s: (*%s)(n),\n", aliasField, aliasType)
+	out.WriteString("\t}\n")
+	out.WriteString("\terr := json.Unmarshal(data, &fields)\n")
+	for _, field := range fields {
+		unmarshal := "Unmarshal" + field.union
+		helper := ""
+		switch field.shape {
+		case typeParamShape:
+			helper = "unmarshalAs"
+		case pointerShape:
+			helper = "unmarshalPointer"
+		case sliceShape:
+			helper = "unmarshalSlice"
+		case slicePointerShape:
+			g.helpers["unmarshalSlice"] = true
+			helper = "unmarshalSlicePointer"
+		case mapShape:
+			helper = "unmarshalMap"
+		case optionalShape:
+			helper = "unmarshalOptional"
+		}
+		call := fmt.Sprintf("%s(fields.%s)", unmarshal, field.name)
+		if helper != "" {
+			g.helpers[helper] = true
+			call = fmt.Sprintf("%s%s(fields.%s, %s)", helper, field.typeArgs, field.name,
+				unmarshal)
+		}
+		out.WriteString("\tif err == nil {\n")
+		fmt.Fprintf(out, "\t\tn.%s, err = %s\n", field.name, call)
+		out.WriteString("\t}\n")
+	}
+	out.WriteString("\treturn err\n}\n\n")
+}
+
+const KIND_FIELD = "Kind"
+
+// MAX_LINE_LENGTH is the maximum length of the lines in Go files checked by
+// scripts/find-long-lines.sh
+const MAX_LINE_LENGTH = 100
+
+const JSON_FILENAME = "unions_json.go"
+
+const SECTION_SEPARATOR = "///////////////////////////////////////////////////////////////////" +
+	"/////////////////////////////////\n\n"
+
+// JSON_HELPERS maps the names of the helper functions used by the generated code to their
+// declarations.
+var JSON_HELPERS = map[string]string{
+	"isNullJson": `// isNullJson reports whether data is empty or the JSON null.
+func isNullJson(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	return len(data) == 0 || bytes.Equal(data, []byte("null"))
+}
+`,
+	"unmarshalKind": `// unmarshalKind returns the "Kind" of the JSON object in data.
+func unmarshalKind(data []byte) (string, error) {
+	var fields struct {
+		Kind *string
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", err
+	}
+	if fields.Kind == nil {
+		return "", fmt.Errorf("the JSON object does not have a Kind")
+	}
+	return *fields.Kind, nil
+}
+`,
+	"unmarshalAs": `// unmarshalAs decodes data with unmarshal and returns the result as a T.
+func unmarshalAs[T any, U any](data []byte, unmarshal func([]byte) (U, error)) (T, error) {
+	var result T
+	value, err := unmarshal(data)
+	if err != nil || any(value) == nil {
+		return result, err
+	}
+	result, ok := any(value).(T)
+	if !ok {
+		return result, fmt.Errorf("unexpected %T", value)
+	}
+	return result, nil
+}
+`,
+	"unmarshalPointer": `func unmarshalPointer[T any](
+	data []byte,
+	unmarshal func([]byte) (T, error),
+) (*T, error) {
+	if isNullJson(data) {
+		return nil, nil
+	}
+	value, err := unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+`,
+	"unmarshalSlice": `func unmarshalSlice[T any](
+	data []byte,
+	unmarshal func([]byte) (T, error),
+) ([]T, error) {
+	if isNullJson(data) {
+		return nil, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		value, err := unmarshal(item)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+	return result, nil
+}
+`,
+	"unmarshalSlicePointer": `func unmarshalSlicePointer[T any](
+	data []byte,
+	unmarshal func([]byte) (T, error),
+) (*[]T, error) {
+	if isNullJson(data) {
+		return nil, nil
+	}
+	result, err := unmarshalSlice(data, unmarshal)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+`,
+	"unmarshalMap": `func unmarshalMap[K comparable, T any](
+	data []byte,
+	unmarshal func([]byte) (T, error),
+) (map[K]T, error) {
+	if isNullJson(data) {
+		return nil, nil
+	}
+	var items map[K]json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	result := make(map[K]T, len(items))
+	for key, item := range items {
+		value, err := unmarshal(item)
+		if err != nil {
+			return nil, err
+		}
+		result[key] = value
+	}
+	return result, nil
+}
+`,
+	"unmarshalOptional": `func unmarshalOptional[T any](
+	data []byte,
+	unmarshal func([]byte) (T, error),
+) (mlglib.Optional[T], error) {
+	if isNullJson(data) {
+		return mlglib.None[T](), nil
+	}
+	value, err := unmarshal(data)
+	if err != nil {
+		return mlglib.None[T](), err
+	}
+	return mlglib.Some(value), nil
+}
+`,
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const SCHEMA_DIR = "schemas"
+
+// schemaGenerator generates the JSON Schema of the JSON encoding of the types of a package.
+type schemaGenerator struct {
+	loader *packageLoader
+	root   *goPackage
+	defs   map[string]any
+}
+
+func generateSchema(loader *packageLoader, pkg *goPackage) ([]byte, error) {
+	g := schemaGenerator{
+		loader: loader,
+		root:   pkg,
+		defs:   make(map[string]any),
+	}
+	for _, name := range sortedKeys(pkg.unions) {
+		if _, err := g.typeSchema(pkg, &ast.Ident{Name: name}, nil); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range sortedKeys(pkg.types) {
+		if _, ok := pkg.getStruct(name); !ok {
+			continue
+		}
+		fields, err := pkg.getUnionFields(name)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) > 0 {
+			if _, err := g.typeSchema(pkg, &ast.Ident{Name: name}, nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+	schema := map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title":   fmt.Sprintf("Mathlingua %s", pkg.name),
+		"description": fmt.Sprintf("The JSON encoding of the types in the %s package.  The "+
+			"value of the %q property of an object in a union identifies its type.",
+			pkg.name, KIND_FIELD),
+		"$defs": g.defs,
+	}
+	result, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(result, '\n'), nil
+}
+
+func (g *schemaGenerator) getDefName(pkg *goPackage, name string) string {
+	if pkg == g.root {
+		return name
+	}
+	return pkg.name + "." + name
+}
+
+func ref(defName string) map[string]any {
+	return map[string]any{"$ref": "#/$defs/" + defName}
+}
+
+func nullable(schema map[string]any) map[string]any {
+	if anyOf, ok := schema["anyOf"].([]any); ok && len(anyOf) == 2 {
+		if last, ok := anyOf[1].(map[string]any); ok && last["type"] == "null" {
+			return schema
+		}
+	}
+	return map[string]any{
+		"anyOf": []any{schema, map[string]any{"type": "null"}},
+	}
+}
+
+func (g *schemaGenerator) typeSchema(
+	pkg *goPackage,
+	expr ast.Expr,
+	constraints map[string]ast.Expr,
+) (map[string]any, error) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if constraint, ok := constraints[e.Name]; ok {
+			return g.typeSchema(pkg, constraint, nil)
+		}
+		if u, ok := pkg.unions[e.Name]; ok {
+			return nullable(ref(g.getDefName(pkg, e.Name))), g.unionDef(pkg, u)
+		}
+		if spec, ok := pkg.types[e.Name]; ok {
+			if _, ok := spec.Type.(*ast.StructType); ok {
+				return ref(g.getDefName(pkg, e.Name)), g.structDef(pkg, e.Name)
+			}
+			return g.typeSchema(pkg, spec.Type, nil)
+		}
+		switch e.Name {
+		case "string":
+			return map[string]any{"type": "string"}, nil
+		case "bool":
+			return map[string]any{"type": "boolean"}, nil
+		case "int", "int8", "int16", "int32", "int64", "rune",
+			"uint", "uint8", "uint16", "uint32", "uint64", "byte":
+			return map[string]any{"type": "integer"}, nil
+		case "float32", "float64":
+			return map[string]any{"type": "number"}, nil
+		case "any":
+			return map[string]any{}, nil
+		}
+	case *ast.StarExpr:
+		schema, err := g.typeSchema(pkg, e.X, constraints)
+		return nullable(schema), err
+	case *ast.ArrayType:
+		items, err := g.typeSchema(pkg, e.Elt, constraints)
+		return map[string]any{"type": []string{"array", "null"}, "items": items}, err
+	case *ast.MapType:
+		values, err := g.typeSchema(pkg, e.Value, constraints)
+		return map[string]any{
+			"type":                 []string{"object", "null"},
+			"additionalProperties": values,
+		}, err
+	case *ast.InterfaceType:
+		return map[string]any{}, nil
+	case *ast.SelectorExpr:
+		other, err := g.loader.loadImport(pkg, exprToString(e.X))
+		if err != nil {
+			return nil, err
+		}
+		return g.typeSchema(other, e.Sel, nil)
+	case *ast.IndexExpr:
+		if exprToString(e.X) == "mlglib.Optional" {
+			schema, err := g.typeSchema(pkg, e.Index, constraints)
+			return nullable(schema), err
+		}
+		// the type parameters of a generic struct are described by their constraints
+		return g.typeSchema(pkg, e.X, constraints)
+	}
+	return nil, fmt.Errorf("%s: The type %s cannot be described by a JSON schema",
+		pkg.dir, exprToString(expr))
+}
+
+func (g *schemaGenerator) unionDef(pkg *goPackage, u union) error {
+	defName := g.getDefName(pkg, u.name)
+	if _, ok := g.defs[defName]; ok {
+		return nil
+	}
+	if len(u.elements) == 0 {
+		// no value is a member of an empty union
+		g.defs[defName] = map[string]any{"not": map[string]any{}}
+		return nil
+	}
+	oneOf := make([]any, 0, len(u.elements))
+	g.defs[defName] = map[string]any{"oneOf": &oneOf}
+	for _, element := range u.elements {
+		name := getBaseName(element)
+		if err := g.structDef(pkg, name); err != nil {
+			return err
+		}
+		oneOf = append(oneOf, ref(g.getDefName(pkg, name)))
+	}
+	return nil
+}
+
+func (g *schemaGenerator) structDef(pkg *goPackage, name string) error {
+	defName := g.getDefName(pkg, name)
+	if _, ok := g.defs[defName]; ok {
+		return nil
+	}
+	properties := make(map[string]any)
+	required := make([]string, 0)
+	g.defs[defName] = map[string]any{
+		"type":       "object",
+		"properties": properties,
+		"required":   &required,
+	}
+
+	isElement := false
+	for _, u := range pkg.unions {
+		for _, element := range u.elements {
+			isElement = isElement || getBaseName(element) == name
+		}
+	}
+	if isElement {
+		properties[KIND_FIELD] = map[string]any{"const": name}
+		required = append(required, KIND_FIELD)
+	}
+
+	structType, _ := pkg.getStruct(name)
+	_, constraints := pkg.getTypeParams(name)
+	for _, field := range structType.Fields.List {
+		for _, fieldName := range field.Names {
+			if !fieldName.IsExported() {
+				continue
+			}
+			schema, err := g.typeSchema(pkg, field.Type, constraints)
+			if err != nil {
+				return err
+			}
+			properties[fieldName.Name] = schema
+			required = append(required, fieldName.Name)
+		}
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const WALK_FILENAME = "unions_walk.go"
+
+// NODE_UNION is the union of the nodes visited by the generated Walk and Transform
+// functions, which are only generated for the package that declares the union.
+const NODE_UNION = "MlgNodeKind"
+
+// walkGenerator generates the Walk, Transform, and Clone functions of a package.
+type walkGenerator struct {
+	pkg *goPackage
+	// the types in the NODE_UNION
+	nodes map[string]bool
+	// whether a struct can be copied by assignment
+	plain map[string]bool
+	// whether a struct contains nodes outside of its metadata
+	reaches map[string]bool
+	helpers map[string]bool
+	out     strings.Builder
+}
+
+func generateWalk(pkg *goPackage, header string) ([]byte, error) {
+	g := walkGenerator{
+		pkg:     pkg,
+		nodes:   make(map[string]bool),
+		plain:   make(map[string]bool),
+		reaches: make(map[string]bool),
+		helpers: make(map[string]bool),
+	}
+	for _, element := range pkg.unions[NODE_UNION].elements {
+		g.nodes[getBaseName(element)] = true
+	}
+	nodes := sortedKeys(g.nodes)
+
+	// the structs with nodes in their fields, found by following the fields of the nodes
+	parents := make([]string, 0)
+	g.findStructs(nodes, func(name string) ([]string, error) {
+		children, err := g.getChildren(name)
+		if err != nil || len(children) == 0 {
+			return nil, err
+		}
+		parents = append(parents, name)
+		result := make([]string, 0)
+		for _, field := range children {
+			result = append(result, g.getStructNames(field.Type)...)
+		}
+		return result, nil
+	})
+	// the structs that cannot be copied by assignment and are reachable from the types of
+	// any union of the package
+	copied := make([]string, 0)
+	g.findStructs(pkg.getElementNames(), func(name string) ([]string, error) {
+		if g.isPlain(&ast.Ident{Name: name}, nil) {
+			return nil, nil
+		}
+		copied = append(copied, name)
+		structType, _ := pkg.getStruct(name)
+		result := make([]string, 0)
+		for _, field := range structType.Fields.List {
+			result = append(result, g.getStructNames(field.Type)...)
+		}
+		return result, nil
+	})
+	sort.Strings(parents)
+	sort.Strings(copied)
+
+	g.writeWalk(nodes, parents)
+	g.writeTransform(nodes, parents)
+	g.writeClone(copied)
+	for _, name := range parents {
+		if err := g.writeWalkStruct(name); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range parents {
+		if err := g.writeTransformStruct(name); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range copied {
+		if err := g.writeCloneStruct(name); err != nil {
+			return nil, err
+		}
+	}
+
+	var result strings.Builder
+	result.WriteString(header)
+	result.WriteString("// Code generated by scripts/unions.go from the .unions files. " +
+		"DO NOT EDIT.\n\n")
+	fmt.Fprintf(&result, "package %s\n\n", pkg.name)
+	result.WriteString("import (\n\t\"fmt\"\n")
+	if g.helpers["sortedKeys"] {
+		result.WriteString("\t\"sort\"\n")
+	}
+	if g.helpers["strconv"] {
+		result.WriteString("\t\"strconv\"\n")
+	}
+	if g.helpers["cloneOptional"] {
+		fmt.Fprintf(&result, "\t%q\n", pkg.imports["mlglib"])
+	}
+	result.WriteString(")\n\n")
+	result.WriteString(g.out.String())
+	result.WriteString(SECTION_SEPARATOR)
+	g.helpers["cloneUnion"] = true
+	g.helpers["transformUnion"] = true
+	g.helpers["transformValue"] = true
+	for _, name := range sortedKeys(WALK_HELPERS) {
+		if g.helpers[name] {
+			result.WriteString(WALK_HELPERS[name])
+			result.WriteString("\n")
+		}
+	}
+	return format.Source([]byte(result.String()))
+}
+
+// findStructs calls visit with each struct of the package that is in roots or is returned
+// by visit.
+func (g *walkGenerator) findStructs(roots []string, visit func(string) ([]string, error)) {
+	seen := make(map[string]bool)
+	queue := append([]string{}, roots...)
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if _, ok := g.pkg.getStruct(name); !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		next, _ := visit(name)
+		queue = append(queue, next...)
+	}
+}
+
+// getStructNames returns the names of the types declared in the package that are
+// referenced in the given type.
+func (g *walkGenerator) getStructNames(expr ast.Expr) []string {
+	result := make([]string, 0)
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if _, ok := node.(*ast.SelectorExpr); ok {
+			// only the type arguments of types from other packages are relevant
+			return false
+		}
+		if ident, ok := node.(*ast.Ident); ok {
+			if _, ok := g.pkg.getStruct(ident.Name); ok {
+				result = append(result, ident.Name)
+			}
+		}
+		return true
+	})
+	if index, ok := expr.(*ast.IndexExpr); ok {
+		if _, ok := index.X.(*ast.SelectorExpr); ok {
+			result = append(result, g.getStructNames(index.Index)...)
+		}
+	}
+	return result
+}
+
+// isString reports whether the underlying type of the given type is string.
+func (g *walkGenerator) isString(expr ast.Expr) bool {
+	if spec, ok := g.pkg.types[exprToString(expr)]; ok {
+		return g.isString(spec.Type)
+	}
+	return exprToString(expr) == "string"
+}
+
+func isMetaData(field *ast.Field) bool {
+	return strings.HasSuffix(exprToString(field.Type), "MetaData")
+}
+
+func isOptional(expr ast.Expr) (ast.Expr, bool) {
+	index, ok := expr.(*ast.IndexExpr)
+	if !ok || exprToString(index.X) != "mlglib.Optional" {
+		return nil, false
+	}
+	return index.Index, true
+}
+
+// getChildren returns the fields of the given struct, other than its metadata, that
+// contain nodes.
+func (g *walkGenerator) getChildren(name string) ([]*ast.Field, error) {
+	structType, _ := g.pkg.getStruct(name)
+	_, constraints := g.pkg.getTypeParams(name)
+	result := make([]*ast.Field, 0)
+	for _, field := range structType.Fields.List {
+		if isMetaData(field) || !g.reachesNode(field.Type, constraints) {
+			continue
+		}
+		if len(field.Names) == 0 {
+			return nil, fmt.Errorf("%s: The embedded fields of %s are not supported", g.pkg.dir,
+				name)
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+// isPlain reports whether a value of the given type can be copied by assignment without
+// sharing any memory with the original value.
+func (g *walkGenerator) isPlain(expr ast.Expr, constraints map[string]ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if _, ok := constraints[e.Name]; ok {
+			return false
+		}
+		spec, ok := g.pkg.types[e.Name]
+		if !ok {
+			// a predeclared type
+			return e.Name != "any" && e.Name != "error"
+		}
+		structType, ok := spec.Type.(*ast.StructType)
+		if !ok {
+			return spec.TypeParams == nil && g.isPlain(spec.Type, nil)
+		}
+		if plain, ok := g.plain[e.Name]; ok {
+			return plain
+		}
+		plain := true
+		for _, field := range structType.Fields.List {
+			plain = plain && g.isPlain(field.Type, nil)
+		}
+		g.plain[e.Name] = plain
+		return plain
+	case *ast.ArrayType:
+		return e.Len != nil && g.isPlain(e.Elt, constraints)
+	}
+	return false
+}
+
+// reachesNode reports whether a value of the given type can contain a node.
+func (g *walkGenerator) reachesNode(expr ast.Expr, constraints map[string]ast.Expr) bool {
+	if elem, ok := isOptional(expr); ok {
+		return g.reachesNode(elem, constraints)
+	}
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if constraint, ok := constraints[e.Name]; ok {
+			return g.reachesNode(constraint, nil)
+		}
+		if u, ok := g.pkg.unions[e.Name]; ok {
+			for _, element := range u.elements {
+				if g.reachesNode(&ast.Ident{Name: getBaseName(element)}, nil) {
+					return true
+				}
+			}
+			return false
+		}
+		if g.nodes[e.Name] {
+			return true
+		}
+		structType, ok := g.pkg.getStruct(e.Name)
+		if !ok {
+			return false
+		}
+		if reaches, ok := g.reaches[e.Name]; ok {
+			return reaches
+		}
+		// guards against cycles through pointers
+		g.reaches[e.Name] = false
+		_, structConstraints := g.pkg.getTypeParams(e.Name)
+		reaches := false
+		for _, field := range structType.Fields.List {
+			reaches = reaches ||
+				(!isMetaData(field) && g.reachesNode(field.Type, structConstraints))
+		}
+		g.reaches[e.Name] = reaches
+		return reaches
+	case *ast.StarExpr:
+		return g.reachesNode(e.X, constraints)
+	case *ast.ArrayType:
+		return g.reachesNode(e.Elt, constraints)
+	case *ast.MapType:
+		return g.reachesNode(e.Value, constraints)
+	case *ast.IndexExpr:
+		return g.reachesNode(e.X, constraints)
+	}
+	return false
+}
+
+// getNode returns the argument passed to walker.visit and transformUnion for a value, src,
+// of the given type if the value is a node or a union of nodes.  A node stored by value is
+// passed by its address.
+func (g *walkGenerator) getNode(
+	expr ast.Expr,
+	constraints map[string]ast.Expr,
+	src string,
+) (string, bool, error) {
+	isNode := func(expr ast.Expr) bool {
+		if index, ok := expr.(*ast.IndexExpr); ok {
+			expr = index.X
+		}
+		ident, ok := expr.(*ast.Ident)
+		return ok && g.nodes[ident.Name]
+	}
+	if isNode(expr) {
+		return "&" + src, true, nil
+	}
+	if star, ok := expr.(*ast.StarExpr); ok && isNode(star.X) {
+		return src, true, nil
+	}
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return "", false, nil
+	}
+	if constraint, ok := constraints[ident.Name]; ok {
+		ident, ok = constraint.(*ast.Ident)
+		if !ok {
+			return "", false, nil
+		}
+	}
+	u, ok := g.pkg.unions[ident.Name]
+	if !ok {
+		return "", false, nil
+	}
+	for _, element := range u.elements {
+		if !g.nodes[getBaseName(element)] {
+			return "", false, fmt.Errorf("%s: The union %s contains %s, which is not a %s",
+				g.pkg.dir, u.name, element, NODE_UNION)
+		}
+	}
+	return src, true, nil
+}
+
+// getFunctionSignature returns the declaration of a function with the given name and
+// parameters for the given struct, where the parameters can refer to the struct as %s.
+func (g *walkGenerator) getFunctionSignature(
+	prefix string,
+	name string,
+	params []string,
+	result string,
+) string {
+	typeParams := ""
+	if params, constraints := g.pkg.getTypeParams(name); len(params) > 0 {
+		decls := make([]string, 0, len(params))
+		for _, param := range params {
+			decls = append(decls, fmt.Sprintf("%s %s", param, exprToString(constraints[param])))
+		}
+		typeParams = fmt.Sprintf("[%s]", strings.Join(decls, ", "))
+	}
+	receiverType := g.pkg.getReceiverType(name)
+	for i := range params {
+		params[i] = strings.ReplaceAll(params[i], "%s", receiverType)
+	}
+	result = strings.ReplaceAll(result, "%s", receiverType)
+	if result != "" {
+		result = " " + result
+	}
+	signature := fmt.Sprintf("func %s%s%s(%s)%s {\n", prefix, name, typeParams,
+		strings.Join(params, ", "), result)
+	if len(signature) > MAX_LINE_LENGTH {
+		signature = fmt.Sprintf("func %s%s%s(\n\t%s,\n)%s {\n", prefix, name, typeParams,
+			strings.Join(params, ",\n\t"), result)
+	}
+	return signature
+}
+
+// writeNodeSwitch writes a type switch over the given nodes that calls the function with
+// the given prefix for each node.
+func (g *walkGenerator) writeNodeSwitch(nodes []string, prefix string, args string) {
+	out := &g.out
+	out.WriteString("\tswitch n := node.(type) {\n")
+	for _, name := range nodes {
+		fmt.Fprintf(out, "\tcase *%s:\n", g.pkg.instantiate(name))
+		fmt.Fprintf(out, "\t\t%s%s(%s)\n", prefix, name, args)
+	}
+	out.WriteString("\t}\n")
+}
+
+func (g *walkGenerator) writeWalk(nodes []string, parents []string) {
+	out := &g.out
+	out.WriteString(`
+// PathItem describes the field of a node that contains the next node in the path from
+// the node passed to Walk to a visited node.
+type PathItem struct {
+	Parent MlgNodeKind
+	// the name of the field of the parent, where the names of the fields of the structs
+	// between the parent and the node are separated by dots, for example Using.Using, and
+	// the indices of the structs in slices are in brackets, for example ElseIfThen[0].Then
+	Field string
+	// the index of the node if the field is a slice and -1 otherwise
+	Index int
+	// the key of the node if the field is a map
+	Key string
+}
+
+// Visitor contains the functions called by Walk.  The path passed to the functions is
+// reused by Walk and must be copied if it is retained.
+type Visitor struct {
+	// Enter is called before the children of a node are visited and the children are
+	// skipped if it returns false.
+	Enter func(node MlgNodeKind, path []PathItem) bool
+	// Exit is called after the children of a node are visited, or skipped, for each node
+	// passed to Enter.
+	Exit func(node MlgNodeKind, path []PathItem)
+}
+
+// Walk visits the given node and the nodes in its fields, in the order of the fields,
+// except for the nodes in metadata such as FormulationMetaData.Original.  Nil nodes are
+// not visited.  The nodes are passed to the visitor by pointer and so the visitor can
+// modify the nodes, including the nodes stored by value such as Target.
+func Walk(node MlgNodeKind, visitor Visitor) {
+	w := walker{visitor: visitor}
+	w.walk(node)
+}
+
+type walker struct {
+	visitor Visitor
+	path    []PathItem
+}
+
+func (w *walker) walk(node MlgNodeKind) {
+	if isNilNode(node) {
+		return
+	}
+	if w.visitor.Enter == nil || w.visitor.Enter(node, w.path) {
+		walkChildren(w, node)
+	}
+	if w.visitor.Exit != nil {
+		w.visitor.Exit(node, w.path)
+	}
+}
+
+func (w *walker) visit(parent MlgNodeKind, field string, index int, key string, value any) {
+	node, ok := value.(MlgNodeKind)
+	if !ok {
+		return
+	}
+	w.path = append(w.path, PathItem{
+		Parent: parent,
+		Field:  field,
+		Index:  index,
+		Key:    key,
+	})
+	w.walk(node)
+	w.path = w.path[:len(w.path)-1]
+}
+
+`)
+	out.WriteString("func walkChildren(w *walker, node MlgNodeKind) {\n")
+	g.writeNodeSwitch(g.filterNodes(parents), "walk", `w, n, "", n`)
+	out.WriteString("}\n\n")
+
+	out.WriteString("func isNilNode(node MlgNodeKind) bool {\n")
+	out.WriteString("\tswitch n := node.(type) {\n")
+	out.WriteString("\tcase nil:\n\t\treturn true\n")
+	for _, name := range nodes {
+		fmt.Fprintf(out, "\tcase *%s:\n", g.pkg.instantiate(name))
+		out.WriteString("\t\treturn n == nil\n")
+	}
+	out.WriteString("\t}\n\treturn false\n}\n\n")
+}
+
+// filterNodes returns the given structs that are nodes.
+func (g *walkGenerator) filterNodes(names []string) []string {
+	result := make([]string, 0)
+	for _, name := range names {
+		if g.nodes[name] {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+func (g *walkGenerator) writeTransform(nodes []string, parents []string) {
+	out := &g.out
+	out.WriteString(`
+// Transform replaces each node in the fields of the given node, as visited by Walk, with
+// the result of Transform for the node and returns the result of fn for the given node.
+// That is, fn is called with the nodes in post-order and the nodes are modified in place,
+// so Clone can be used to keep the original node.  Transform panics if the type of a
+// result of fn cannot be stored in the field of the node it replaces.
+func Transform(node MlgNodeKind, fn func(MlgNodeKind) MlgNodeKind) MlgNodeKind {
+	if isNilNode(node) {
+		return node
+	}
+`)
+	g.writeNodeSwitch(g.filterNodes(parents), "transform", "n, fn")
+	out.WriteString("\treturn fn(node)\n}\n\n")
+}
+
+func (g *walkGenerator) writeClone(copied []string) {
+	out := &g.out
+	out.WriteString(`// Clone returns a deep copy of the given node.
+func Clone[T MlgNodeKind](node T) T {
+	return cloneUnion(node)
+}
+
+`)
+	out.WriteString("func cloneAny(node any) any {\n")
+	out.WriteString("\tswitch n := node.(type) {\n")
+	isCopied := make(map[string]bool)
+	for _, name := range copied {
+		isCopied[name] = true
+	}
+	for _, name := range g.pkg.getElementNames() {
+		fmt.Fprintf(out, "\tcase *%s:\n", g.pkg.instantiate(name))
+		out.WriteString("\t\tif n == nil {\n\t\t\treturn n\n\t\t}\n")
+		if isCopied[name] {
+			fmt.Fprintf(out, "\t\tresult := clone%s(*n)\n", name)
+		} else {
+			out.WriteString("\t\tresult := *n\n")
+		}
+		out.WriteString("\t\treturn &result\n")
+	}
+	out.WriteString("\t}\n\treturn node\n}\n\n")
+}
+
+func (g *walkGenerator) writeWalkStruct(name string) error {
+	out := &g.out
+	out.WriteString(g.getFunctionSignature("walk", name,
+		[]string{"w *walker", "parent MlgNodeKind", "prefix string", "n *%s"}, ""))
+	children, _ := g.getChildren(name)
+	_, constraints := g.pkg.getTypeParams(name)
+	for _, field := range children {
+		for _, fieldName := range field.Names {
+			err := g.writeWalkField("\t", field.Type, constraints, "n."+fieldName.Name,
+				fieldName.Name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	out.WriteString("}\n\n")
+	return nil
+}
+
+func (g *walkGenerator) writeWalkField(
+	indent string,
+	expr ast.Expr,
+	constraints map[string]ast.Expr,
+	src string,
+	fieldName string,
+) error {
+	out := &g.out
+	field := fmt.Sprintf("prefix+%q", fieldName)
+	if node, ok, err := g.getNode(expr, constraints, src); ok || err != nil {
+		fmt.Fprintf(out, "%sw.visit(parent, %s, -1, \"\", %s)\n", indent, field, node)
+		return err
+	}
+	unsupported := fmt.Errorf("%s: The type %s of %s is not supported", g.pkg.dir,
+		exprToString(expr), src)
+	if elem, ok := isOptional(expr); ok {
+		node, ok, err := g.getNode(elem, constraints, "node")
+		if !ok || err != nil || strings.HasPrefix(node, "&") {
+			return unsupported
+		}
+		fmt.Fprintf(out, "%sif node, ok := %s.Get(); ok {\n", indent, src)
+		fmt.Fprintf(out, "%s\tw.visit(parent, %s, -1, \"\", node)\n", indent, field)
+		fmt.Fprintf(out, "%s}\n", indent)
+		return nil
+	}
+	switch e := expr.(type) {
+	case *ast.Ident, *ast.IndexExpr:
+		name := exprToString(expr)
+		if index, ok := e.(*ast.IndexExpr); ok {
+			name = exprToString(index.X)
+		}
+		if _, ok := g.pkg.getStruct(name); !ok {
+			return unsupported
+		}
+		g.writeCall(indent, "walk"+name, "w", "parent", fmt.Sprintf("prefix+%q", fieldName+"."),
+			"&"+src)
+		return nil
+	case *ast.StarExpr:
+		fmt.Fprintf(out, "%sif %s != nil {\n", indent, src)
+		var err error
+		if _, ok := e.X.(*ast.ArrayType); ok {
+			err = g.writeWalkField(indent+"\t", e.X, constraints, "(*"+src+")", fieldName)
+		} else if _, ok := g.pkg.getStruct(exprToString(e.X)); ok {
+			g.writeCall(indent+"\t", "walk"+exprToString(e.X), "w", "parent",
+				fmt.Sprintf("prefix+%q", fieldName+"."), src)
+		} else {
+			err = unsupported
+		}
+		fmt.Fprintf(out, "%s}\n", indent)
+		return err
+	case *ast.ArrayType:
+		node, ok, err := g.getNode(e.Elt, constraints, src+"[i]")
+		if err != nil || e.Len != nil {
+			return unsupported
+		}
+		fmt.Fprintf(out, "%sfor i := range %s {\n", indent, src)
+		if ok {
+			fmt.Fprintf(out, "%s\tw.visit(parent, %s, i, \"\", %s)\n", indent, field, node)
+		} else if _, ok := g.pkg.getStruct(exprToString(e.Elt)); ok {
+			g.helpers["strconv"] = true
+			g.writeCall(indent+"\t", "walk"+exprToString(e.Elt), "w", "parent",
+				fmt.Sprintf("prefix+%q+strconv.Itoa(i)+%q", fieldName+"[", "]."), "&"+src+"[i]")
+		} else {
+			return unsupported
+		}
+		fmt.Fprintf(out, "%s}\n", indent)
+		return nil
+	case *ast.MapType:
+		node, ok, err := g.getNode(e.Value, constraints, "node")
+		if !ok || err != nil || !g.isString(e.Key) {
+			return unsupported
+		}
+		g.helpers["sortedKeys"] = true
+		fmt.Fprintf(out, "%sfor _, key := range sortedKeys(%s) {\n", indent, src)
+		// a node stored by value in a map is copied and then stored after it is visited
+		fmt.Fprintf(out, "%s\tnode := %s[key]\n", indent, src)
+		fmt.Fprintf(out, "%s\tw.visit(parent, %s, -1, string(key), %s)\n", indent, field, node)
+		if strings.HasPrefix(node, "&") {
+			fmt.Fprintf(out, "%s\t%s[key] = node\n", indent, src)
+		}
+		fmt.Fprintf(out, "%s}\n", indent)
+		return nil
+	}
+	return unsupported
+}
+
+func (g *walkGenerator) writeTransformStruct(name string) error {
+	out := &g.out
+	out.WriteString(g.getFunctionSignature("transform", name,
+		[]string{"n *%s", "fn func(MlgNodeKind) MlgNodeKind"}, ""))
+	children, _ := g.getChildren(name)
+	_, constraints := g.pkg.getTypeParams(name)
+	for _, field := range children {
+		for _, fieldName := range field.Names {
+			err := g.writeTransformField("\t", field.Type, constraints, "n."+fieldName.Name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	out.WriteString("}\n\n")
+	return nil
+}
+
+func (g *walkGenerator) writeTransformField(
+	indent string,
+	expr ast.Expr,
+	constraints map[string]ast.Expr,
+	dst string,
+) error {
+	out := &g.out
+	assign := func(indent string, dst string, node string) {
+		if strings.HasPrefix(node, "&") {
+			fmt.Fprintf(out, "%s%s = *transformValue(%s, fn)\n", indent, dst, node)
+		} else {
+			fmt.Fprintf(out, "%s%s = transformUnion(%s, fn)\n", indent, dst, node)
+		}
+	}
+	if node, ok, err := g.getNode(expr, constraints, dst); ok || err != nil {
+		assign(indent, dst, node)
+		return err
+	}
+	unsupported := fmt.Errorf("%s: The type %s of %s is not supported", g.pkg.dir,
+		exprToString(expr), dst)
+	if elem, ok := isOptional(expr); ok {
+		node, ok, err := g.getNode(elem, constraints, "node")
+		if !ok || err != nil || strings.HasPrefix(node, "&") {
+			return unsupported
+		}
+		fmt.Fprintf(out, "%sif node, ok := %s.Get(); ok {\n", indent, dst)
+		fmt.Fprintf(out, "%s\t%s = mlglib.Some(transformUnion(node, fn))\n", indent, dst)
+		fmt.Fprintf(out, "%s}\n", indent)
+		return nil
+	}
+	switch e := expr.(type) {
+	case *ast.Ident, *ast.IndexExpr:
+		name := exprToString(expr)
+		if index, ok := e.(*ast.IndexExpr); ok {
+			name = exprToString(index.X)
+		}
+		if _, ok := g.pkg.getStruct(name); !ok {
+			return unsupported
+		}
+		fmt.Fprintf(out, "%stransform%s(&%s, fn)\n", indent, name, dst)
+		return nil
+	case *ast.StarExpr:
+		fmt.Fprintf(out, "%sif %s != nil {\n", indent, dst)
+		var err error
+		if _, ok := e.X.(*ast.ArrayType); ok {
+			err = g.writeTransformField(indent+"\t", e.X, constraints, "(*"+dst+")")
+		} else if _, ok := g.pkg.getStruct(exprToString(e.X)); ok {
+			fmt.Fprintf(out, "%s\ttransform%s(%s, fn)\n", indent, exprToString(e.X), dst)
+		} else {
+			err = unsupported
+		}
+		fmt.Fprintf(out, "%s}\n", indent)
+		return err
+	case *ast.ArrayType:
+		node, ok, err := g.getNode(e.Elt, constraints, dst+"[i]")
+		if err != nil || e.Len != nil {
+			return unsupported
+		}
+		fmt.Fprintf(out, "%sfor i := range %s {\n", indent, dst)
+		if ok {
+			assign(indent+"\t", dst+"[i]", node)
+		} else if _, ok := g.pkg.getStruct(exprToString(e.Elt)); ok {
+			fmt.Fprintf(out, "%s\ttransform%s(&%s[i], fn)\n", indent, exprToString(e.Elt),
+				dst)
+		} else {
+			return unsupported
+		}
+		fmt.Fprintf(out, "%s}\n", indent)
+		return nil
+	case *ast.MapType:
+		node, ok, err := g.getNode(e.Value, constraints, "node")
+		if !ok || err != nil {
+			return unsupported
+		}
+		fmt.Fprintf(out, "%sfor key, node := range %s {\n", indent, dst)
+		assign(indent+"\t", dst+"[key]", node)
+		fmt.Fprintf(out, "%s}\n", indent)
+		return nil
+	}
+	return unsupported
+}
+
+// writeCall writes a call of the given function, which can include the left side of an
+// assignment, with the arguments on separate lines if the call is too long.
+func (g *walkGenerator) writeCall(indent string, function string, args ...string) {
+	line := fmt.Sprintf("%s%s(%s)\n", indent, function, strings.Join(args, ", "))
+	if len(line) > MAX_LINE_LENGTH {
+		line = fmt.Sprintf("%s%s(\n%s\t%s,\n%s)\n", indent, function, indent,
+			strings.Join(args, ",\n"+indent+"\t"), indent)
+	}
+	g.out.WriteString(line)
+}
+
+func (g *walkGenerator) writeCloneStruct(name string) error {
+	out := &g.out
+	out.WriteString(g.getFunctionSignature("clone", name, []string{"n %s"}, "%s"))
+	out.WriteString("\tresult := n\n")
+	structType, _ := g.pkg.getStruct(name)
+	_, constraints := g.pkg.getTypeParams(name)
+	for _, field := range structType.Fields.List {
+		if g.isPlain(field.Type, constraints) {
+			continue
+		}
+		for _, fieldName := range field.Names {
+			source, err := g.cloneExpr(field.Type, constraints, "n."+fieldName.Name)
+			if err != nil {
+				return err
+			}
+			value, err := parser.ParseExpr(source)
+			if err != nil {
+				return err
+			}
+			call, ok := value.(*ast.CallExpr)
+			if !ok {
+				fmt.Fprintf(out, "\tresult.%s = %s\n", fieldName.Name, exprToString(value))
+				continue
+			}
+			args := make([]string, 0, len(call.Args))
+			for _, arg := range call.Args {
+				args = append(args, exprToString(arg))
+			}
+			g.writeCall("\t", fmt.Sprintf("result.%s = %s", fieldName.Name,
+				exprToString(call.Fun)), args...)
+		}
+	}
+	out.WriteString("\treturn result\n}\n\n")
+	return nil
+}
+
+// cloneExpr returns an expression for a deep copy of src, which has the given type.
+func (g *walkGenerator) cloneExpr(
+	expr ast.Expr,
+	constraints map[string]ast.Expr,
+	src string,
+) (string, error) {
+	if g.isPlain(expr, constraints) {
+		return src, nil
+	}
+	unsupported := fmt.Errorf("%s: Cannot copy %s of type %s", g.pkg.dir, src,
+		exprToString(expr))
+	if elem, ok := isOptional(expr); ok {
+		fn, err := g.cloneFunc(elem, constraints)
+		g.helpers["cloneOptional"] = true
+		return fmt.Sprintf("cloneOptional(%s, %s)", src, fn), err
+	}
+	useHelper := func(name string) {
+		g.helpers[name] = true
+		// the helpers for pointers to slices use the helpers for slices
+		if base, ok := strings.CutSuffix(name, "Pointer"); ok && strings.HasSuffix(base, "Slice") {
+			g.helpers[base] = true
+		}
+	}
+	copyOrClone := func(elem ast.Expr, helper string) (string, error) {
+		if g.isPlain(elem, constraints) {
+			useHelper("copy" + helper)
+			return fmt.Sprintf("copy%s(%s)", helper, src), nil
+		}
+		fn, err := g.cloneFunc(elem, constraints)
+		useHelper("clone" + helper)
+		return fmt.Sprintf("clone%s(%s, %s)", helper, src, fn), err
+	}
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if _, ok := constraints[e.Name]; ok {
+			return fmt.Sprintf("cloneUnion(%s)", src), nil
+		}
+		if _, ok := g.pkg.unions[e.Name]; ok {
+			return fmt.Sprintf("cloneUnion(%s)", src), nil
+		}
+		if _, ok := g.pkg.getStruct(e.Name); ok {
+			return fmt.Sprintf("clone%s(%s)", e.Name, src), nil
+		}
+	case *ast.IndexExpr:
+		if _, ok := g.pkg.getStruct(exprToString(e.X)); ok {
+			return fmt.Sprintf("clone%s(%s)", exprToString(e.X), src), nil
+		}
+	case *ast.StarExpr:
+		if array, ok := e.X.(*ast.ArrayType); ok && array.Len == nil {
+			return copyOrClone(array.Elt, "SlicePointer")
+		}
+		return copyOrClone(e.X, "Pointer")
+	case *ast.ArrayType:
+		if e.Len == nil {
+			return copyOrClone(e.Elt, "Slice")
+		}
+	case *ast.MapType:
+		return copyOrClone(e.Value, "Map")
+	}
+	return "", unsupported
+}
+
+// cloneFunc returns an expression for a function that returns a deep copy of a value of
+// the given type.
+func (g *walkGenerator) cloneFunc(
+	expr ast.Expr,
+	constraints map[string]ast.Expr,
+) (string, error) {
+	if ident, ok := expr.(*ast.Ident); ok {
+		if _, ok := constraints[ident.Name]; ok {
+			return fmt.Sprintf("cloneUnion[%s]", ident.Name), nil
+		}
+		if _, ok := g.pkg.unions[ident.Name]; ok {
+			return fmt.Sprintf("cloneUnion[%s]", ident.Name), nil
+		}
+		if _, ok := g.pkg.getStruct(ident.Name); ok {
+			return "clone" + ident.Name, nil
+		}
+	}
+	if index, ok := expr.(*ast.IndexExpr); ok {
+		if _, ok := g.pkg.getStruct(exprToString(index.X)); ok {
+			return fmt.Sprintf("clone%s[%s]", exprToString(index.X),
+				exprToString(index.Index)), nil
+		}
+	}
+	value, err := g.cloneExpr(expr, constraints, "value")
+	typeName := exprToString(expr)
+	return fmt.Sprintf("func(value %s) %s {\n\treturn %s\n}", typeName, typeName, value), err
+}
+
+// WALK_HELPERS maps the names of the helper functions used by the generated Walk,
+// Transform, and Clone functions to their declarations.
+var WALK_HELPERS = map[string]string{
+	"sortedKeys": `func sortedKeys[K ~string, T any](items map[K]T) []K {
+	result := make([]K, 0, len(items))
+	for key := range items {
+		result = append(result, key)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+	return result
+}
+`,
+	"transformUnion": `// transformUnion returns the result of Transform for the given node as a T.
+func transformUnion[T any](node T, fn func(MlgNodeKind) MlgNodeKind) T {
+	value, ok := any(node).(MlgNodeKind)
+	if !ok {
+		return node
+	}
+	var result T
+	transformed := Transform(value, fn)
+	if transformed == nil {
+		return result
+	}
+	result, ok = transformed.(T)
+	if !ok {
+		panic(fmt.Sprintf("Transform: Cannot replace a %T with a %T", node, transformed))
+	}
+	return result
+}
+`,
+	"transformValue": `// transformValue returns the result of Transform for a node stored by value,
+// which must have the same type as the node.
+func transformValue[T any, P interface {
+	*T
+	MlgNodeKind
+}](node P, fn func(MlgNodeKind) MlgNodeKind) P {
+	transformed := Transform(node, fn)
+	result, ok := transformed.(P)
+	if !ok || result == nil {
+		panic(fmt.Sprintf("Transform: Cannot replace a %T with a %T", node, transformed))
+	}
+	return result
+}
+`,
+	"cloneUnion": `func cloneUnion[T any](node T) T {
+	result, _ := cloneAny(node).(T)
+	return result
+}
+`,
+	"cloneOptional": `func cloneOptional[T any](
+	value mlglib.Optional[T],
+	clone func(T) T,
+) mlglib.Optional[T] {
+	if item, ok := value.Get(); ok {
+		return mlglib.Some(clone(item))
+	}
+	return mlglib.None[T]()
+}
+`,
+	"copyPointer": `func copyPointer[T any](value *T) *T {
+	if value == nil {
+		return nil
+	}
+	result := *value
+	return &result
+}
+`,
+	"clonePointer": `func clonePointer[T any](value *T, clone func(T) T) *T {
+	if value == nil {
+		return nil
+	}
+	result := clone(*value)
+	return &result
+}
+`,
+	"copySlicePointer": `func copySlicePointer[T any](items *[]T) *[]T {
+	if items == nil {
+		return nil
+	}
+	result := copySlice(*items)
+	return &result
+}
+`,
+	"cloneSlicePointer": `func cloneSlicePointer[T any](items *[]T, clone func(T) T) *[]T {
+	if items == nil {
+		return nil
+	}
+	result := cloneSlice(*items, clone)
+	return &result
+}
+`,
+	"copySlice": `func copySlice[T any](items []T) []T {
+	if items == nil {
+		return nil
+	}
+	result := make([]T, len(items))
+	copy(result, items)
+	return result
+}
+`,
+	"cloneSlice": `func cloneSlice[T any](items []T, clone func(T) T) []T {
+	if items == nil {
+		return nil
+	}
+	result := make([]T, len(items))
+	for i, item := range items {
+		result[i] = clone(item)
+	}
+	return result
+}
+`,
+	"copyMap": `func copyMap[K comparable, T any](items map[K]T) map[K]T {
+	if items == nil {
+		return nil
+	}
+	result := make(map[K]T, len(items))
+	for key, item := range items {
+		result[key] = item
+	}
+	return result
+}
+`,
+	"cloneMap": `func cloneMap[K comparable, T any](items map[K]T, clone func(T) T) map[K]T {
+	if items == nil {
+		return nil
+	}
+	result := make(map[K]T, len(items))
+	for key, item := range items {
+		result[key] = clone(item)
+	}
+	return result
+}
+`,
 }
@@ -4,12 +4,14 @@ import { Routes, Route, BrowserRouter } from "react-router-dom";
 
 import styles from './App.module.css';
 import { MainPage } from './pages/MainPage';
+import { TopicPage } from './pages/TopicPage';
 
 export const App = () => {
   return (
     <BrowserRouter>
       <div className={styles.App}>
         <Routes>
+          <Route path="/topics/:id" element={<TopicPage />} />
           <Route path="/*" element={<MainPage />} />
         </Routes>
       </div>
@@ -1,10 +1,12 @@
 import React from 'react';
 
-import { Document } from '../../types';
+import { Document, Note } from '../../types';
+import { NoteView } from './NoteView';
 import { MultiTopLevelItem } from './MultiTopLevelItem';
 
 export interface DocumentViewProps {
   node: Document;
+  notes: { [id: string]: Note[] } | null;
   isOnSmallScreen: boolean;
 }
 
@@ -15,6 +17,8 @@ export const DocumentView = (props: DocumentViewProps) => {
         props.node.Nodes?.map((node, index) => (
           <span id={node?.MetaData.Id ?? ''}  key={index}>
             <MultiTopLevelItem node={node} isOnSmallScreen={props.isOnSmallScreen} />
+            {props.notes?.[node?.MetaData.Id ?? '']?.map(note =>
+              <NoteView key={note.Id} note={note} />)}
           </span>
         ))
       }
@@ -0,0 +1,18 @@
+import React from 'react';
+
+import styles from './NoteView.module.css';
+
+import { Note } from '../../types';
+import { MarkdownView } from '../../design/MarkdownView';
+
+export interface NoteViewProps {
+  note: Note;
+}
+
+export const NoteView = (props: NoteViewProps) => {
+  return (
+    <div id={props.note.Id} className={styles.note}>
+      <MarkdownView text={props.note.Content} />
+    </div>
+  );
+};
@@ -74,7 +74,9 @@ export function MainPage() {
     <div className={styles.mainContent}>
       {activePathData?.Document && (
         <div className={styles.page}>
-          <DocumentView node={activePathData?.Document} isOnSmallScreen={isOnSmallScreen} />
+          <DocumentView node={activePathData?.Document}
+                        notes={activePathData?.Notes ?? null}
+                        isOnSmallScreen={isOnSmallScreen} />
         </div>
       )}
       {(prevItem !== null || nextItem !== null) &&
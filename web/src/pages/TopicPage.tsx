@@ -0,0 +1,51 @@
+import React from 'react';
+
+import styles from './TopicPage.module.css';
+
+import { useFetch } from 'usehooks-ts';
+import { useParams } from 'react-router-dom';
+import { EntryResponse, TopicMember, TopicsResponse } from '../types';
+import { MarkdownView } from '../design/MarkdownView';
+import { MultiTopLevelItem } from '../components/ast/MultiTopLevelItem';
+
+export function TopicPage() {
+  const { id } = useParams();
+  const { data: topicsData } = useFetch<TopicsResponse>('/api/topics');
+
+  const topic = topicsData?.Topics?.find(topic => topic.Id === id);
+  if (!topic) {
+    return null;
+  }
+
+  const isOnSmallScreen = window.innerWidth <= 864;
+  return (
+    <div className={styles.page}>
+      <h1 className={styles.name}>{topic.Name}</h1>
+      <MarkdownView text={topic.Content} />
+      {topic.Members?.map(member =>
+        <TopicMemberView key={member.Signature}
+                         member={member}
+                         isOnSmallScreen={isOnSmallScreen} />)}
+    </div>
+  );
+}
+
+interface TopicMemberViewProps {
+  member: TopicMember;
+  isOnSmallScreen: boolean;
+}
+
+const TopicMemberView = (props: TopicMemberViewProps) => {
+  const { data: entryData } = useFetch<EntryResponse>(
+    props.member.Id ? `/api/entry/id/${encodeURIComponent(props.member.Id)}` : undefined);
+
+  if (!props.member.Id) {
+    return <div className={styles.unknownMember}>{props.member.Signature}</div>;
+  }
+
+  if (!entryData?.Entry) {
+    return null;
+  }
+
+  return <MultiTopLevelItem node={entryData.Entry} isOnSmallScreen={props.isOnSmallScreen} />;
+};
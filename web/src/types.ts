@@ -34,10 +34,66 @@ export interface PathsResponse {
 	Paths: PathLabelPair[] | null;
 }
 
+export interface TopicMember {
+  Signature: string;
+  Id: string;
+}
+
+export interface Topic {
+  Id: string;
+  Name: string;
+  Content: string;
+  Path: string;
+  Members: TopicMember[] | null;
+}
+
+export interface TopicsResponse {
+  Error: string;
+  Topics: Topic[] | null;
+}
+
+export type SemanticTokenType =
+  | "section"
+  | "groupId"
+  | "binder"
+  | "variable"
+  | "name"
+  | "command"
+  | "unresolvedCommand"
+  | "builtin"
+  | "operator"
+  | "keyword"
+  | "text"
+  | "comment";
+
+export interface SemanticToken {
+  Type: SemanticTokenType;
+  Position: Position;
+  Length: number;
+}
+
+export interface SemanticTokensResponse {
+  Error: string;
+  Tokens: SemanticToken[] | null;
+}
+
+export interface EntryResponse {
+  Error: string;
+  Entry: TopLevelNodeKind;
+}
+
+export interface Note {
+  Id: string;
+  Content: string;
+  On: string;
+  Path: string;
+}
+
 export interface PageResponse {
 	Error: string;
 	Diagnostics: Diagnostic[] | null;
 	Document: Document;
+	Notes: { [id: string]: Note[] } | null;
 }
 
 export interface MetaData {
@@ -46,6 +102,7 @@ export interface MetaData {
 }
 
 export interface TextBlock {
+	Kind: "TextBlock";
 	Type: TextBlockType;
 	Text: string;
 	MetaData: MetaData;
@@ -60,6 +117,7 @@ export interface Document {
 }
 
 export interface Group {
+	Kind: "Group";
 	Type: GroupType;
 	Id: string | null;
 	Sections: Section[] | null;
@@ -85,6 +143,7 @@ export type ArgumentDataKind = Group |
   ArgumentTextArgumentData | null;
 
 export interface TextArgumentData {
+	Kind: "TextArgumentData";
 	Type: TextArgumentDataKind;
   Text: string;
   MetaData: MetaData;
@@ -96,6 +155,7 @@ export interface FormulationArgumentDataMetaData {
 }
 
 export interface FormulationArgumentData {
+	Kind: "FormulationArgumentData";
 	Type: FormulationArgumentDataKind;
 	Text: string;
 	MetaData: MetaData;
@@ -103,6 +163,7 @@ export interface FormulationArgumentData {
 }
 
 export interface ArgumentTextArgumentData {
+	Kind: "ArgumentTextArgumentData";
 	Type: ArgumentTextArgumentDataKind;
 	Text: string;
 	MetaData: MetaData;